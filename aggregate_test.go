@@ -0,0 +1,56 @@
+package dleq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/types"
+)
+
+func TestAggregateKeys(t *testing.T) {
+	for _, curve := range allCurves() {
+		keys := make([]types.Point, 4)
+		for i := range keys {
+			keys[i] = curve.ScalarBaseMul(curve.NewRandomScalar())
+		}
+
+		agg, coeffs, err := types.AggregateKeys(curve, keys)
+		require.NoError(t, err)
+		require.Len(t, coeffs, len(keys))
+
+		expected := curve.Identity()
+		for i, p := range keys {
+			expected = expected.Add(p.ScalarMul(coeffs[i]))
+		}
+		require.True(t, expected.Equals(agg))
+
+		// deterministic
+		again, againCoeffs, err := types.AggregateKeys(curve, keys)
+		require.NoError(t, err)
+		require.True(t, agg.Equals(again))
+		for i := range coeffs {
+			require.True(t, coeffs[i].Eq(againCoeffs[i]))
+		}
+
+		// independent of the order of the keys; the coefficients follow them
+		reversed := []types.Point{keys[3], keys[2], keys[1], keys[0]}
+		revAgg, revCoeffs, err := types.AggregateKeys(curve, reversed)
+		require.NoError(t, err)
+		require.True(t, agg.Equals(revAgg))
+		for i := range coeffs {
+			require.True(t, coeffs[i].Eq(revCoeffs[len(coeffs)-1-i]))
+		}
+
+		// a different set of keys gives different coefficients
+		subAgg, subCoeffs, err := types.AggregateKeys(curve, keys[:3])
+		require.NoError(t, err)
+		require.False(t, agg.Equals(subAgg))
+		require.False(t, coeffs[0].Eq(subCoeffs[0]))
+
+		_, _, err = types.AggregateKeys(curve, nil)
+		require.Error(t, err)
+		_, _, err = types.AggregateKeys(curve, []types.Point{keys[0], curve.Identity()})
+		require.Error(t, err)
+	}
+}
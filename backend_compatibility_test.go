@@ -30,8 +30,7 @@ func TestBackendCompatibility(t *testing.T) {
 	pubKey := curve.ScalarBaseMul(privKey)
 
 	// Create test message point (generator * 2)
-	two := curve.ScalarFromInt(2)
-	msgPoint := curve.ScalarBaseMul(two)
+	msgPoint := curve.BaseMulSmall(2)
 
 	// Test signature generation and verification
 	sig, err := curve.Sign(privKey, msgPoint)
@@ -78,6 +77,7 @@ func TestBackendCompatibility(t *testing.T) {
 	point2Hex := hex.EncodeToString(point2.Encode())
 	pointProductHex := hex.EncodeToString(pointProduct.Encode())
 
+	t.Logf("DETERMINISTIC_BACKEND=%s", secp256k1.BackendName())
 	t.Logf("DETERMINISTIC_PUBKEY=%s", pubKeyHex)
 	t.Logf("DETERMINISTIC_SCALAR_SUM=%s", scalarSumHex)
 	t.Logf("DETERMINISTIC_SCALAR_PRODUCT=%s", scalarProductHex)
@@ -1,6 +1,7 @@
 package dleq
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/pokt-network/go-dleq/secp256k1"
@@ -21,7 +22,7 @@ func BenchmarkScalarBaseMul(b *testing.B) {
 func BenchmarkScalarMul(b *testing.B) {
 	curve := secp256k1.NewCurve()
 	scalar := curve.NewRandomScalar()
-	point := curve.ScalarBaseMul(curve.ScalarFromInt(2))
+	point := curve.BaseMulSmall(2)
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -119,6 +120,62 @@ func BenchmarkDLEQProofVerification(b *testing.B) {
 	}
 }
 
+// BenchmarkDLEQProofVerificationParallel benchmarks verifying a single
+// proof with VerifyParallel; compare with BenchmarkDLEQProofVerification.
+func BenchmarkDLEQProofVerificationParallel(b *testing.B) {
+	curveA := secp256k1.NewCurve()
+	curveB := secp256k1.NewCurve()
+
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	proof, err := NewProof(curveA, curveB, x)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				err := proof.VerifyParallel(curveA, curveB, workers)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkDLEQProofVerificationWithContext benchmarks full DLEQ proof
+// verification reusing a VerifyContext; compare its allocations with
+// BenchmarkDLEQProofVerification's using -benchmem.
+func BenchmarkDLEQProofVerificationWithContext(b *testing.B) {
+	curveA := secp256k1.NewCurve()
+	curveB := secp256k1.NewCurve()
+
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	proof, err := NewProof(curveA, curveB, x)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var ctx VerifyContext
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err := proof.VerifyWith(&ctx, curveA, curveB)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 // BenchmarkScalarDecoding benchmarks scalar decoding from bytes
 func BenchmarkScalarDecoding(b *testing.B) {
 	curve := secp256k1.NewCurve()
@@ -148,3 +205,29 @@ func BenchmarkPointDecoding(b *testing.B) {
 		}
 	}
 }
+
+// BenchmarkPointDecodingUnchecked compares DecodeToPoint with
+// DecodeToPointUnchecked on every curve.
+func BenchmarkPointDecodingUnchecked(b *testing.B) {
+	for _, curve := range allCurves() {
+		encoded := curve.ScalarBaseMul(curve.NewRandomScalar()).Encode()
+		decoders := []struct {
+			name   string
+			decode func([]byte) (Point, error)
+		}{
+			{"checked", curve.DecodeToPoint},
+			{"unchecked", curve.DecodeToPointUnchecked},
+		}
+
+		for _, d := range decoders {
+			b.Run(curve.Name()+"/"+d.name, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					_, err := d.decode(encoded)
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
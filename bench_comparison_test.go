@@ -3,6 +3,7 @@ package dleq
 import (
 	"testing"
 
+	"github.com/pokt-network/go-dleq/ed25519"
 	"github.com/pokt-network/go-dleq/secp256k1"
 )
 
@@ -21,7 +22,7 @@ func BenchmarkComparison_ScalarBaseMul(b *testing.B) {
 func BenchmarkComparison_ScalarMul(b *testing.B) {
 	curve := secp256k1.NewCurve()
 	scalar := curve.NewRandomScalar()
-	point := curve.ScalarBaseMul(curve.ScalarFromInt(2))
+	point := curve.BaseMulSmall(2)
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -112,7 +113,7 @@ func BenchmarkComparison_ParallelScalarMul(b *testing.B) {
 	b.RunParallel(func(pb *testing.PB) {
 		curve := secp256k1.NewCurve()
 		scalar := curve.NewRandomScalar()
-		point := curve.ScalarBaseMul(curve.ScalarFromInt(2))
+		point := curve.BaseMulSmall(2)
 
 		for pb.Next() {
 			_ = curve.ScalarMul(scalar, point)
@@ -133,3 +134,85 @@ func BenchmarkComparison_Memory(b *testing.B) {
 		_ = result.Encode() // Force full evaluation
 	}
 }
+
+// BenchmarkComparison_Equals compares a point with another decoded point
+func BenchmarkComparison_Equals(b *testing.B) {
+	curve := secp256k1.NewCurve()
+	point := curve.ScalarBaseMul(curve.NewRandomScalar())
+	other := point.Copy()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = point.Equals(other)
+	}
+}
+
+// BenchmarkComparison_EqualsBytes compares a point with a precomputed encoding
+func BenchmarkComparison_EqualsBytes(b *testing.B) {
+	curve := secp256k1.NewCurve()
+	point := curve.ScalarBaseMul(curve.NewRandomScalar())
+	enc := point.Encode()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = point.EqualsBytes(enc)
+	}
+}
+
+// BenchmarkComparison_DLEQProofSize reports the serialized size of a
+// secp256k1/ed25519 proof alongside its verification time. The proof is
+// linear in the bit size of the smaller curve.
+func BenchmarkComparison_DLEQProofSize(b *testing.B) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	proof, err := NewProof(curveA, curveB, x)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err := proof.Verify(curveA, curveB)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ReportMetric(float64(len(proof.Serialize())), "proof-bytes")
+}
+
+// BenchmarkComparison_ScalarArithmetic measures scalar Add and Mul, which the
+// Decred backend does on fixed-size ModNScalar values
+func BenchmarkComparison_ScalarArithmetic(b *testing.B) {
+	curve := secp256k1.NewCurve()
+	x := curve.NewRandomScalar()
+	y := curve.NewRandomScalar()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = x.Add(y).Mul(y)
+	}
+}
+
+// BenchmarkComparison_SignCompact compares backend performance for signing
+// without the DER encoding
+func BenchmarkComparison_SignCompact(b *testing.B) {
+	curve := secp256k1.NewCurve().(*secp256k1.CurveImpl)
+	privKey := curve.NewRandomScalar()
+	msgPoint := curve.BasePoint()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := curve.SignCompact(privKey, msgPoint)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package benchmarks
+
+import (
+	"testing"
+
+	"github.com/pokt-network/go-dleq/secp256k1"
+	"github.com/pokt-network/go-dleq/types"
+)
+
+// The challenge of a Schnorr-style proof is H(msg || L || R). These
+// benchmarks compare building the preimage from Encode with writing the
+// encodings into a reused buffer.
+
+func BenchmarkChallenge_Encode(b *testing.B) {
+	curve := secp256k1.NewCurve()
+	msg := []byte("challenge message")
+	L := curve.ScalarBaseMul(curve.NewRandomScalar())
+	R := curve.ScalarBaseMul(curve.NewRandomScalar())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		preimage := append(append(append([]byte{}, msg...), L.Encode()...), R.Encode()...)
+		_, err := curve.HashToScalar(preimage)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkChallenge_EncodeInto(b *testing.B) {
+	curve := secp256k1.NewCurve()
+	msg := []byte("challenge message")
+	L := curve.ScalarBaseMul(curve.NewRandomScalar())
+	R := curve.ScalarBaseMul(curve.NewRandomScalar())
+
+	size := curve.CompressedPointSize()
+	buf := make([]byte, len(msg)+2*size)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		off := copy(buf, msg)
+		n, err := L.(types.PointEncodeInto).EncodeInto(buf[off:])
+		if err != nil {
+			b.Fatal(err)
+		}
+		off += n
+
+		_, err = R.(types.PointEncodeInto).EncodeInto(buf[off:])
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		_, err = curve.HashToScalar(buf)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkChallenge_Builder(b *testing.B) {
+	curve := secp256k1.NewCurve()
+	msg := []byte("challenge message")
+	L := curve.ScalarBaseMul(curve.NewRandomScalar())
+	R := curve.ScalarBaseMul(curve.NewRandomScalar())
+
+	var builder types.ChallengeBuilder
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		builder.Reset()
+		builder.AddMessage(msg)
+		builder.AddPoint(L)
+		builder.AddPoint(R)
+		_, err := builder.Challenge(curve)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkChallenge_HashPointsToScalar(b *testing.B) {
+	curve := secp256k1.NewCurve()
+	msg := []byte("challenge message")
+	L := curve.ScalarBaseMul(curve.NewRandomScalar())
+	R := curve.ScalarBaseMul(curve.NewRandomScalar())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := types.HashPointsToScalar(curve, msg, L, R)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
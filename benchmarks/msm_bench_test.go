@@ -0,0 +1,62 @@
+package benchmarks
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pokt-network/go-dleq/secp256k1"
+	"github.com/pokt-network/go-dleq/types"
+)
+
+// BenchmarkMultiScalarMul sweeps the window size at several input lengths,
+// to check that DefaultWindowBits picks a window close to the fastest one.
+// The default is included as w=0. The Ethereum backend doesn't use windows,
+// so this is only meaningful for the Decred backend.
+func BenchmarkMultiScalarMul(b *testing.B) {
+	curve := secp256k1.NewCurve()
+
+	for _, n := range []int{64, 256, 1024} {
+		scalars := make([]types.Scalar, n)
+		points := make([]types.Point, n)
+		for i := range scalars {
+			scalars[i] = curve.NewRandomScalar()
+			points[i] = curve.ScalarBaseMul(curve.NewRandomScalar())
+		}
+
+		for _, w := range []int{0, 2, 3, 4, 5, 6, 7, 8, 9, 10} {
+			b.Run(fmt.Sprintf("n=%d/w=%d", n, w), func(b *testing.B) {
+				cfg := types.MultiScalarMulConfig{WindowBits: w}
+				for i := 0; i < b.N; i++ {
+					_, err := types.MultiScalarMulWithConfig(curve, scalars, points, cfg)
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkMultiScalarMul_Naive is the baseline of one ScalarMul per point.
+func BenchmarkMultiScalarMul_Naive(b *testing.B) {
+	curve := secp256k1.NewCurve()
+
+	for _, n := range []int{64, 256, 1024} {
+		scalars := make([]types.Scalar, n)
+		points := make([]types.Point, n)
+		for i := range scalars {
+			scalars[i] = curve.NewRandomScalar()
+			points[i] = curve.ScalarBaseMul(curve.NewRandomScalar())
+		}
+
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				products := make([]types.Point, n)
+				for j := range points {
+					products[j] = curve.ScalarMul(scalars[j], points[j])
+				}
+				_ = types.SumPoints(curve, products...)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package dleq
+
+import (
+	"fmt"
+)
+
+const proofBundleVersion = 1
+
+// ProofBundle is a proof together with the statement it proves: the curves
+// and the public points on each that share a discrete log. It can be stored
+// and verified on its own.
+type ProofBundle struct {
+	CurveA, CurveB Curve
+	PointA, PointB Point
+	Proof          *Proof
+}
+
+// NewProofBundle returns a bundle for the given proof, taking the public
+// points from the proof's commitments.
+func NewProofBundle(curveA, curveB Curve, proof *Proof) *ProofBundle {
+	return &ProofBundle{
+		CurveA: curveA,
+		CurveB: curveB,
+		PointA: proof.CommitmentA,
+		PointB: proof.CommitmentB,
+		Proof:  proof,
+	}
+}
+
+// Verify verifies the proof against the bundled curves and public points.
+func (b *ProofBundle) Verify() error {
+	return b.Proof.VerifyAgainst(b.CurveA, b.CurveB, b.PointA, b.PointB)
+}
+
+// MarshalBinary encodes the bundle as a version byte, the identifiers of
+// both curves, both public points and the serialized proof.
+func (b *ProofBundle) MarshalBinary() ([]byte, error) {
+	idA, err := curveID(b.CurveA)
+	if err != nil {
+		return nil, err
+	}
+
+	idB, err := curveID(b.CurveB)
+	if err != nil {
+		return nil, err
+	}
+
+	out := []byte{proofBundleVersion, idA, idB}
+	out = append(out, b.PointA.Encode()...)
+	out = append(out, b.PointB.Encode()...)
+	out = append(out, b.Proof.Serialize()...)
+	return out, nil
+}
+
+// UnmarshalBinary decodes a bundle encoded by MarshalBinary. It doesn't
+// verify the proof.
+func (b *ProofBundle) UnmarshalBinary(in []byte) error {
+	if len(in) < 3 {
+		return errInputBytesTooShort
+	}
+
+	if in[0] != proofBundleVersion {
+		return fmt.Errorf("unsupported proof bundle version %d", in[0])
+	}
+
+	curveA, err := CurveByID(in[1])
+	if err != nil {
+		return err
+	}
+
+	curveB, err := CurveByID(in[2])
+	if err != nil {
+		return err
+	}
+
+	in = in[3:]
+	pointLenA, pointLenB := curveA.CompressedPointSize(), curveB.CompressedPointSize()
+	if len(in) < pointLenA+pointLenB {
+		return errInputBytesTooShort
+	}
+
+	pointA, err := curveA.DecodeToPoint(in[:pointLenA])
+	if err != nil {
+		return fmt.Errorf("failed to decode point A: %w", err)
+	}
+
+	pointB, err := curveB.DecodeToPoint(in[pointLenA : pointLenA+pointLenB])
+	if err != nil {
+		return fmt.Errorf("failed to decode point B: %w", err)
+	}
+
+	proof := new(Proof)
+	err = proof.Deserialize(curveA, curveB, in[pointLenA+pointLenB:])
+	if err != nil {
+		return fmt.Errorf("failed to deserialize proof: %w", err)
+	}
+
+	*b = ProofBundle{
+		CurveA: curveA,
+		CurveB: curveB,
+		PointA: pointA,
+		PointB: pointB,
+		Proof:  proof,
+	}
+	return nil
+}
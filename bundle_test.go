@@ -0,0 +1,71 @@
+package dleq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+func TestProofBundle_RoundTrip(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	bundle := NewProofBundle(curveA, curveB, proof)
+	require.NoError(t, bundle.Verify())
+
+	enc, err := bundle.MarshalBinary()
+	require.NoError(t, err)
+
+	decoded := new(ProofBundle)
+	require.NoError(t, decoded.UnmarshalBinary(enc))
+	require.Equal(t, "secp256k1", decoded.CurveA.Name())
+	require.Equal(t, "ed25519", decoded.CurveB.Name())
+	require.True(t, decoded.PointA.Equals(bundle.PointA))
+	require.True(t, decoded.PointB.Equals(bundle.PointB))
+	require.Equal(t, proof.Serialize(), decoded.Proof.Serialize())
+
+	// verify after deserializing, with no curves supplied by the caller
+	require.NoError(t, decoded.Verify())
+
+	reenc, err := decoded.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, enc, reenc)
+}
+
+func TestProofBundle_Invalid(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	enc, err := NewProofBundle(curveA, curveB, proof).MarshalBinary()
+	require.NoError(t, err)
+
+	// a statement the proof isn't for fails verification
+	tampered := append([]byte{}, enc...)
+	other := curveA.ScalarBaseMul(curveA.NewRandomScalar()).Encode()
+	copy(tampered[3:], other)
+	decoded := new(ProofBundle)
+	require.NoError(t, decoded.UnmarshalBinary(tampered))
+	require.Error(t, decoded.Verify())
+
+	// unknown version and curve identifiers
+	for _, i := range []int{0, 1, 2} {
+		bad := append([]byte{}, enc...)
+		bad[i] = 0xff
+		require.Error(t, new(ProofBundle).UnmarshalBinary(bad))
+	}
+
+	// truncated input
+	require.Error(t, new(ProofBundle).UnmarshalBinary(enc[:2]))
+	require.Error(t, new(ProofBundle).UnmarshalBinary(enc[:40]))
+}
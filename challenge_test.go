@@ -0,0 +1,127 @@
+package dleq
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/types"
+)
+
+func TestChallengeBuilder(t *testing.T) {
+	for _, curve := range allCurves() {
+		msg := []byte("challenge message")
+		L := curve.ScalarBaseMul(curve.NewRandomScalar())
+		R := curve.ScalarBaseMul(curve.NewRandomScalar())
+
+		preimage := append(append(append([]byte{}, msg...), L.Encode()...), R.Encode()...)
+		expected, err := curve.HashToScalar(preimage)
+		require.NoError(t, err)
+
+		var builder types.ChallengeBuilder
+		for i := 0; i < 2; i++ {
+			builder.Reset()
+			builder.AddMessage(msg)
+			builder.AddPoint(L)
+			builder.AddPoint(plainPoint{R})
+			require.Equal(t, preimage, builder.Bytes())
+
+			e, err := builder.Challenge(curve)
+			require.NoError(t, err)
+			require.True(t, expected.Eq(e))
+		}
+
+		// once the buffer has grown, laying out the preimage doesn't allocate
+		allocs := testing.AllocsPerRun(16, func() {
+			builder.Reset()
+			builder.AddMessage(msg)
+			builder.AddPoint(L)
+			builder.AddPoint(L)
+		})
+		require.Zero(t, allocs)
+	}
+}
+
+func TestHashPointsToScalar(t *testing.T) {
+	for _, curve := range allCurves() {
+		msg := []byte("challenge message")
+		points := []Point{
+			curve.BasePoint(),
+			curve.ScalarBaseMul(curve.NewRandomScalar()),
+			plainPoint{curve.ScalarBaseMul(curve.NewRandomScalar())},
+		}
+
+		preimage := append([]byte{}, msg...)
+		for _, p := range points {
+			preimage = append(preimage, p.Encode()...)
+		}
+
+		expected, err := curve.HashToScalar(preimage)
+		require.NoError(t, err)
+
+		e, err := types.HashPointsToScalar(curve, msg, points...)
+		require.NoError(t, err)
+		require.True(t, expected.Eq(e))
+
+		// no message or no points
+		expected, err = curve.HashToScalar(preimage[len(msg):])
+		require.NoError(t, err)
+		e, err = types.HashPointsToScalar(curve, nil, points...)
+		require.NoError(t, err)
+		require.True(t, expected.Eq(e))
+
+		expected, err = curve.HashToScalar(msg)
+		require.NoError(t, err)
+		e, err = types.HashPointsToScalar(curve, msg)
+		require.NoError(t, err)
+		require.True(t, expected.Eq(e))
+	}
+}
+
+// zeroHashCurve hashes the first zeros preimages it's given to zero.
+type zeroHashCurve struct {
+	Curve
+	zeros     int
+	preimages [][]byte
+}
+
+func (c *zeroHashCurve) HashToScalar(in []byte) (Scalar, error) {
+	c.preimages = append(c.preimages, append([]byte{}, in...))
+	if len(c.preimages) <= c.zeros {
+		return c.Curve.ScalarFromInt(0), nil
+	}
+
+	return c.Curve.HashToScalar(in)
+}
+
+func TestHashToNonZeroScalar(t *testing.T) {
+	for _, curve := range allCurves() {
+		in := []byte("blinder")
+		expected, err := curve.HashToScalar(in)
+		require.NoError(t, err)
+
+		s, err := types.HashToNonZeroScalar(curve, in)
+		require.NoError(t, err)
+		require.True(t, expected.Eq(s))
+
+		// zero hashes are retried with a counter
+		zeros := &zeroHashCurve{Curve: curve, zeros: 2}
+		s, err = types.HashToNonZeroScalar(zeros, in)
+		require.NoError(t, err)
+		require.False(t, s.IsZero())
+		require.Equal(t, [][]byte{
+			[]byte("blinder"),
+			[]byte("blinder\x00\x00\x00\x01"),
+			[]byte("blinder\x00\x00\x00\x02"),
+		}, zeros.preimages)
+
+		expected, err = curve.HashToScalar([]byte("blinder\x00\x00\x00\x02"))
+		require.NoError(t, err)
+		require.True(t, expected.Eq(s))
+
+		// a hash that's always zero fails rather than looping forever
+		_, err = types.HashToNonZeroScalar(&zeroHashCurve{Curve: curve, zeros: math.MaxInt}, in)
+		require.Error(t, err)
+	}
+}
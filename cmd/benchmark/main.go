@@ -82,9 +82,11 @@ func runComparison(duration string) {
 		ethResults = runBenchmarks("-tags=ethereum_secp256k1", "1", duration)
 	}
 
-	// Display comparison
+	// Display comparison, or the Decred results alone when Ethereum isn't available
 	if len(ethResults) > 0 {
 		displayComparison(decredResults, ethResults)
+	} else if len(decredResults) > 0 {
+		displayResults("Decred (Pure Go)", decredResults)
 	}
 }
 
@@ -209,6 +211,21 @@ func displayComparison(decred, ethereum []BenchmarkResult) {
 	}
 }
 
+func displayResults(backend string, results []BenchmarkResult) {
+	fmt.Printf("\n%s=== %s Performance ===%s\n\n", colorGreen, backend, colorReset)
+
+	// Table header
+	fmt.Printf("%-30s %15s %25s\n", "Operation", "Time", "Memory")
+	fmt.Println(strings.Repeat("-", 75))
+
+	for _, r := range results {
+		mem := fmt.Sprintf("%d B, %d allocs", r.BytesOp, r.AllocsOp)
+		fmt.Printf("%-30s %15s %25s\n", r.Name, formatTime(r.NsOp), mem)
+	}
+
+	fmt.Println("\n" + strings.Repeat("-", 75))
+}
+
 func formatTime(ns float64) string {
 	switch {
 	case ns >= 1_000_000_000:
@@ -250,20 +267,28 @@ func runCompatibilityTest() bool {
 	// Parse outputs to verify both backends produce same deterministic results
 	fmt.Println("  • Comparing deterministic outputs...")
 
-	decredValues := extractDeterministicValues(string(decredOut))
-	ethValues := extractDeterministicValues(string(ethOut))
+	decredBackend, decredValues := extractDeterministicValues(string(decredOut))
+	ethBackend, ethValues := extractDeterministicValues(string(ethOut))
 
 	if len(decredValues) == 0 || len(ethValues) == 0 {
 		fmt.Printf("%s    ❌ Could not extract deterministic values from test outputs%s\n", colorRed, colorReset)
 		return false
 	}
 
+	// eg. without a C compiler the "ethereum" run silently builds the Decred
+	// backend, and comparing it with itself proves nothing
+	if decredBackend != "decred" || ethBackend != "ethereum" {
+		fmt.Printf("%s    ❌ Runs used the %q and %q backends, expected \"decred\" and \"ethereum\"%s\n",
+			colorRed, decredBackend, ethBackend, colorReset)
+		return false
+	}
+
 	// Compare extracted values
 	for key, decredValue := range decredValues {
 		if ethValue, exists := ethValues[key]; !exists || ethValue != decredValue {
 			fmt.Printf("%s    ❌ Backends produce different outputs for %s%s\n", colorRed, key, colorReset)
-			fmt.Printf("      Decred:   %s\n", decredValue)
-			fmt.Printf("      Ethereum: %s\n", ethValue)
+			fmt.Printf("      %-9s %s\n", decredBackend+":", decredValue)
+			fmt.Printf("      %-9s %s\n", ethBackend+":", ethValue)
 			return false
 		}
 	}
@@ -272,8 +297,10 @@ func runCompatibilityTest() bool {
 	return true
 }
 
-func extractDeterministicValues(output string) map[string]string {
-	values := make(map[string]string)
+// extractDeterministicValues returns the DETERMINISTIC_ values logged by
+// TestBackendCompatibility, with the backend that produced them separately.
+func extractDeterministicValues(output string) (backend string, values map[string]string) {
+	values = make(map[string]string)
 	scanner := bufio.NewScanner(strings.NewReader(output))
 
 	for scanner.Scan() {
@@ -289,5 +316,7 @@ func extractDeterministicValues(output string) map[string]string {
 		}
 	}
 
-	return values
+	backend = values["BACKEND"]
+	delete(values, "BACKEND")
+	return backend, values
 }
@@ -0,0 +1,31 @@
+package dleq
+
+// OpenBitCommitment reports whether the commitment opens to the given bit
+// with the given blinder, ie. whether it equals bit*G + blinding*H, where G
+// and H are the curve's BasePoint and AltBasePoint. These are the Pedersen
+// commitments made to each bit of the witness in a proof.
+func OpenBitCommitment(curve Curve, commitment Point, bit uint8, blinding Scalar) bool {
+	if bit > 1 {
+		return false
+	}
+
+	c := curve.ScalarMul(blinding, curve.AltBasePoint())
+	if bit == 1 {
+		c = c.Add(curve.BasePoint())
+	}
+
+	return c.Equals(commitment)
+}
+
+// CommitmentBytes returns the compressed encoding of every bit commitment
+// in the proof, two per bit: for bit i, entry 2i is the commitment on curve
+// A and entry 2i+1 the one on curve B, with bits from least significant.
+// This is the order they're serialized in, and it won't change.
+func (p *Proof) CommitmentBytes() [][]byte {
+	out := make([][]byte, 0, 2*len(p.proofs))
+	for _, bp := range p.proofs {
+		out = append(out, bp.commitmentA.commitment.Encode(), bp.commitmentB.commitment.Encode())
+	}
+
+	return out
+}
@@ -0,0 +1,55 @@
+package dleq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+func TestOpenBitCommitment(t *testing.T) {
+	for _, curve := range allCurves() {
+		// 0b10: a 0-bit followed by a 1-bit
+		x := []byte{0b10}
+		commitments, err := generateCommitments(curve, x, 2, systemRandom)
+		require.NoError(t, err)
+
+		for i, c := range commitments {
+			bit := getBit(x, uint64(i))
+			require.True(t, OpenBitCommitment(curve, c.commitment, bit, c.blinder))
+			require.False(t, OpenBitCommitment(curve, c.commitment, 1-bit, c.blinder))
+			require.False(t, OpenBitCommitment(curve, c.commitment, 2, c.blinder))
+
+			wrongBlinder := c.blinder.Add(curve.ScalarFromInt(1))
+			require.False(t, OpenBitCommitment(curve, c.commitment, bit, wrongBlinder))
+		}
+	}
+}
+
+func TestProof_CommitmentBytes(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	commitments := proof.CommitmentBytes()
+	bits := int(min(curveA.BitSize(), curveB.BitSize()))
+	require.Len(t, commitments, 2*bits)
+
+	// the commitments are in the order they're serialized in
+	ser := proof.Serialize()
+	pointLenA, pointLenB := curveA.CompressedPointSize(), curveB.CompressedPointSize()
+	bitProofLen := pointLenA + pointLenB + 3*curveA.ScalarSize() + 3*curveB.ScalarSize()
+	for i := 0; i < bits; i++ {
+		require.Len(t, commitments[2*i], pointLenA)
+		require.Len(t, commitments[2*i+1], pointLenB)
+
+		offset := pointLenA + pointLenB + 1 + i*bitProofLen
+		require.Equal(t, ser[offset:offset+pointLenA], commitments[2*i])
+		require.Equal(t, ser[offset+pointLenA:offset+pointLenA+pointLenB], commitments[2*i+1])
+	}
+}
@@ -0,0 +1,34 @@
+package dleq
+
+import (
+	"testing"
+
+	"github.com/pokt-network/go-dleq/secp256k1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignCompact(t *testing.T) {
+	curve := secp256k1.NewCurve().(*secp256k1.CurveImpl)
+	privKey := curve.NewRandomScalar()
+	pubKey := curve.ScalarBaseMul(privKey)
+	msgPoint := curve.BaseMulSmall(2)
+
+	sig, err := curve.SignCompact(privKey, msgPoint)
+	require.NoError(t, err)
+	require.True(t, curve.VerifyCompact(pubKey, msgPoint, sig))
+
+	// the DER signature carries the same r and s
+	der, err := curve.Sign(privKey, msgPoint)
+	require.NoError(t, err)
+	require.True(t, curve.Verify(pubKey, msgPoint, der))
+	require.True(t, curve.Verify(pubKey, msgPoint, secp256k1.CompactToDER(sig)))
+
+	require.False(t, curve.VerifyCompact(pubKey, curve.BaseMulSmall(3), sig))
+	require.False(t, curve.VerifyCompact(curve.BaseMulSmall(3), msgPoint, sig))
+
+	tampered := sig
+	tampered[63] ^= 1
+	require.False(t, curve.VerifyCompact(pubKey, msgPoint, tampered))
+
+	require.False(t, curve.VerifyCompact(pubKey, msgPoint, [64]byte{}))
+}
@@ -0,0 +1,33 @@
+package dleq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+func TestConvertPoint(t *testing.T) {
+	curve := secp256k1.NewCurve()
+	p := curve.ScalarBaseMul(curve.NewRandomScalar())
+
+	// a point of another type that encodes as a secp256k1 point
+	res, err := secp256k1.ConvertPoint(plainPoint{p})
+	require.NoError(t, err)
+	require.IsType(t, &secp256k1.PointImpl{}, res)
+	require.True(t, res.Equals(p))
+	require.True(t, res.Add(p).Equals(p.Add(p)))
+
+	res, err = secp256k1.ConvertPoint(p)
+	require.NoError(t, err)
+	require.True(t, res.Equals(p))
+
+	ed := ed25519.NewCurve()
+	_, err = secp256k1.ConvertPoint(ed.BasePoint())
+	require.Error(t, err)
+
+	_, err = secp256k1.ConvertPoint(nil)
+	require.Error(t, err)
+}
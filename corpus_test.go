@@ -0,0 +1,98 @@
+//go:build testcorpus
+// +build testcorpus
+
+package dleq
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+	"github.com/pokt-network/go-dleq/testcorpus"
+)
+
+// TestDecodeCorpus runs every malformed input through the decode functions
+// it targets, which must return an error rather than panic.
+func TestDecodeCorpus(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	curves := map[string]Curve{
+		testcorpus.Secp256k1: curveA,
+		testcorpus.Ed25519:   curveB,
+	}
+
+	names := make(map[string]bool)
+	for _, in := range testcorpus.Inputs() {
+		require.False(t, names[in.Name], "duplicate input %q", in.Name)
+		names[in.Name] = true
+
+		var errs map[string]error
+		require.NotPanics(t, func() {
+			errs = decodeCorpusInput(curves, in)
+		}, in.Name)
+
+		require.NotEmpty(t, errs, in.Name)
+		for fn, err := range errs {
+			require.Error(t, err, "%s accepted %s", fn, in.Name)
+		}
+	}
+}
+
+// decodeCorpusInput returns the error of every decode function the input
+// targets, by function name.
+func decodeCorpusInput(curves map[string]Curve, in testcorpus.Input) map[string]error {
+	curveA := curves[testcorpus.Secp256k1]
+	curveB := curves[testcorpus.Ed25519]
+
+	switch in.Target {
+	case testcorpus.Point:
+		curve := curves[in.Curve]
+		p, err := curve.DecodeToPoint(in.Data)
+		if err == nil {
+			err = curve.ValidatePoint(p)
+		}
+
+		_, batchErr := curve.DecodeToPoints(in.Data, 1)
+		return map[string]error{"DecodeToPoint": err, "DecodeToPoints": batchErr}
+	case testcorpus.Scalar:
+		_, err := curves[in.Curve].DecodeToScalar(in.Data)
+		return map[string]error{"DecodeToScalar": err}
+	case testcorpus.DERSignature:
+		_, err := secp256k1.DERToCompact(in.Data)
+		verifyErr := errors.New("signature rejected")
+		if curveA.Verify(curveA.BasePoint(), curveA.BasePoint(), in.Data) {
+			verifyErr = nil
+		}
+
+		return map[string]error{"DERToCompact": err, "Verify": verifyErr}
+	case testcorpus.Proof:
+		var p Proof
+		err := p.Deserialize(curveA, curveB, in.Data)
+		_, streamErr := ReadProofFrom(bytes.NewReader(in.Data), curveA, curveB)
+		binErr := p.UnmarshalBinary(append([]byte{CurveIDSecp256k1, CurveIDEd25519}, in.Data...))
+		return map[string]error{"Deserialize": err, "ReadProofFrom": streamErr, "UnmarshalBinary": binErr}
+	default:
+		return nil
+	}
+}
+
+// FuzzDeserialize checks that decoding any input returns rather than
+// panics, starting from the malformed proofs of the corpus.
+func FuzzDeserialize(f *testing.F) {
+	for _, data := range testcorpus.Data(testcorpus.Proof, "") {
+		f.Add(data)
+	}
+
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var p Proof
+		if p.Deserialize(curveA, curveB, data) == nil {
+			_ = p.Verify(curveA, curveB)
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package dleq
+
+import (
+	"fmt"
+)
+
+// CurveIDs returns the identifiers, as registered with RegisterCurve, of
+// the curves the proof was made or decoded for. A curve that isn't
+// registered has identifier 0.
+//
+// Serialize doesn't record the curves, so a proof decoded with Deserialize
+// or ReadProofFrom has the identifiers of the curves passed in. Only
+// MarshalBinary stores them with the proof.
+func (p *Proof) CurveIDs() (idA, idB uint8) {
+	return p.curveIDA, p.curveIDB
+}
+
+// VerifyExpecting verifies the proof against the given curves, after
+// checking that the proof's curve identifiers are those of the given
+// curves. Used with a proof decoded by UnmarshalBinary, it rejects a proof
+// made for a different curve pair than the verifier expects.
+func (p *Proof) VerifyExpecting(curveA, curveB Curve) error {
+	idA, err := curveID(curveA)
+	if err != nil {
+		return err
+	}
+
+	idB, err := curveID(curveB)
+	if err != nil {
+		return err
+	}
+
+	if p.curveIDA != idA || p.curveIDB != idB {
+		return fmt.Errorf("proof is for curves %d and %d, expected %d and %d", p.curveIDA, p.curveIDB, idA, idB)
+	}
+
+	return p.Verify(curveA, curveB)
+}
+
+// MarshalBinary encodes the proof as the identifiers of both curves
+// followed by the serialized proof. Both curves must be registered.
+func (p *Proof) MarshalBinary() ([]byte, error) {
+	if p.curveIDA == 0 || p.curveIDB == 0 {
+		return nil, fmt.Errorf("proof curves are not registered")
+	}
+
+	return append([]byte{p.curveIDA, p.curveIDB}, p.Serialize()...), nil
+}
+
+// UnmarshalBinary decodes a proof encoded by MarshalBinary, for the curves
+// registered with the encoded identifiers. It doesn't verify the proof.
+func (p *Proof) UnmarshalBinary(in []byte) error {
+	return p.UnmarshalBinaryWithOptions(in, ProofDecodeOptions{})
+}
+
+// UnmarshalBinaryWithOptions is like UnmarshalBinary, with the given
+// options. MaxSize applies to the serialized proof, not counting the curve
+// identifiers.
+func (p *Proof) UnmarshalBinaryWithOptions(in []byte, opts ProofDecodeOptions) error {
+	if len(in) < 2 {
+		return errInputBytesTooShort
+	}
+
+	curveA, err := CurveByID(in[0])
+	if err != nil {
+		return err
+	}
+
+	curveB, err := CurveByID(in[1])
+	if err != nil {
+		return err
+	}
+
+	return p.DeserializeWithOptions(curveA, curveB, in[2:], opts)
+}
+
+// setCurves records the properties of the curves the proof is for.
+func (p *Proof) setCurves(curveA, curveB Curve) {
+	p.challengeBits = challengeBits(curveA, curveB)
+
+	// unregistered curves are left as 0
+	p.curveIDA, _ = curveID(curveA)
+	p.curveIDB, _ = curveID(curveB)
+}
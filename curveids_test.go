@@ -0,0 +1,43 @@
+package dleq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+func TestProof_VerifyExpecting(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	idA, idB := proof.CurveIDs()
+	require.Equal(t, CurveIDSecp256k1, idA)
+	require.Equal(t, CurveIDEd25519, idB)
+
+	enc, err := proof.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, []byte{CurveIDSecp256k1, CurveIDEd25519}, enc[:2])
+	require.Equal(t, proof.Serialize(), enc[2:])
+
+	var decoded Proof
+	require.NoError(t, decoded.UnmarshalBinary(enc))
+	idA, idB = decoded.CurveIDs()
+	require.Equal(t, CurveIDSecp256k1, idA)
+	require.Equal(t, CurveIDEd25519, idB)
+	require.NoError(t, decoded.VerifyExpecting(curveA, curveB))
+
+	err = decoded.VerifyExpecting(secp256k1.NewCurve(), secp256k1.NewCurve())
+	require.ErrorContains(t, err, "proof is for curves 1 and 2, expected 1 and 1")
+	require.Error(t, decoded.VerifyExpecting(curveB, curveA))
+
+	// unknown identifiers and short input
+	require.Error(t, decoded.UnmarshalBinary(append([]byte{CurveIDSecp256k1, 0xff}, enc[2:]...)))
+	require.ErrorIs(t, decoded.UnmarshalBinary(enc[:1]), errInputBytesTooShort)
+}
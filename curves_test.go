@@ -0,0 +1,813 @@
+package dleq
+
+import (
+	"bytes"
+	stded25519 "crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	mrand "math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+	"github.com/pokt-network/go-dleq/types"
+)
+
+// allCurves returns an instance of every curve implemented by this module.
+func allCurves() []Curve {
+	return []Curve{
+		secp256k1.NewCurve(),
+		ed25519.NewCurve(),
+	}
+}
+
+// TestAltBasePoint checks that the alternate generator H used for the bit
+// commitments is a valid full-order generator distinct from G. If H were the
+// identity, G or -G the commitments would not be binding.
+func TestAltBasePoint(t *testing.T) {
+	for _, curve := range allCurves() {
+		G := curve.BasePoint()
+		H := curve.AltBasePoint()
+
+		require.NoError(t, curve.ValidatePoint(G))
+		require.NoError(t, curve.ValidatePoint(H))
+		require.False(t, H.IsZero())
+		require.False(t, H.Equals(G))
+		require.False(t, H.Equals(curve.ScalarBaseMul(curve.ScalarFromInt(1).Negate())))
+	}
+}
+
+func TestCurve_ReduceWide(t *testing.T) {
+	secpOrder, _ := new(big.Int).SetString("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141", 16)
+	edOrder, _ := new(big.Int).SetString("1000000000000000000000000000000014def9dea2f79cd65812631a5cf5d3ed", 16)
+
+	testCases := []struct {
+		curve        Curve
+		order        *big.Int
+		littleEndian bool
+	}{
+		{secp256k1.NewCurve(), secpOrder, false},
+		{ed25519.NewCurve(), edOrder, true},
+	}
+
+	// toInt reads an integer in the curve's scalar byte order.
+	toInt := func(b []byte, littleEndian bool) *big.Int {
+		cp := append([]byte{}, b...)
+		if littleEndian {
+			for i, j := 0, len(cp)-1; i < j; i, j = i+1, j-1 {
+				cp[i], cp[j] = cp[j], cp[i]
+			}
+		}
+		return new(big.Int).SetBytes(cp)
+	}
+
+	for _, tc := range testCases {
+		for i := 0; i < 64; i++ {
+			var in [64]byte
+			_, err := rand.Read(in[:])
+			require.NoError(t, err)
+
+			expected := new(big.Int).Mod(toInt(in[:], tc.littleEndian), tc.order)
+			require.Zero(t, expected.Cmp(toInt(tc.curve.ReduceWide(in).Encode(), tc.littleEndian)))
+
+			lo, hi := tc.curve.SplitReduce(in)
+			expectedLo := new(big.Int).Mod(toInt(in[:32], tc.littleEndian), tc.order)
+			expectedHi := new(big.Int).Mod(toInt(in[32:], tc.littleEndian), tc.order)
+			require.Zero(t, expectedLo.Cmp(toInt(lo.Encode(), tc.littleEndian)))
+			require.Zero(t, expectedHi.Cmp(toInt(hi.Encode(), tc.littleEndian)))
+		}
+
+		// values with leading zero bytes must not be shifted when reduced
+		var small [64]byte
+		small[63] = 1
+		if tc.littleEndian {
+			small = [64]byte{1}
+		}
+		require.True(t, tc.curve.ReduceWide(small).Eq(tc.curve.ScalarFromInt(1)))
+	}
+}
+
+func TestCurve_ScalarSize(t *testing.T) {
+	for _, curve := range allCurves() {
+		require.Equal(t, 32, curve.ScalarSize())
+		require.Len(t, curve.NewRandomScalar().Encode(), curve.ScalarSize())
+	}
+}
+
+func TestCurve_RandomScalarBelow(t *testing.T) {
+	for _, curve := range allCurves() {
+		// small bound: every value in [1, bound) should show up
+		const bound = 10
+		seen := make(map[string]bool)
+		for i := 0; i < 1000; i++ {
+			s, err := curve.RandomScalarBelow(big.NewInt(bound))
+			require.NoError(t, err)
+			require.False(t, s.IsZero())
+			seen[string(s.Encode())] = true
+		}
+		require.Len(t, seen, bound-1)
+		for i := uint32(1); i < bound; i++ {
+			require.True(t, seen[string(curve.ScalarFromInt(i).Encode())])
+		}
+
+		// bound that isn't a power of two, so candidates do get rejected
+		large := new(big.Int).Lsh(big.NewInt(3), 200)
+		for i := 0; i < 100; i++ {
+			s, err := curve.RandomScalarBelow(large)
+			require.NoError(t, err)
+			require.Equal(t, -1, scalarToInt(curve, s).Cmp(large))
+		}
+
+		_, err := curve.RandomScalarBelow(curve.Order())
+		require.NoError(t, err)
+
+		_, err = curve.RandomScalarBelow(big.NewInt(1))
+		require.Error(t, err)
+		_, err = curve.RandomScalarBelow(big.NewInt(0))
+		require.Error(t, err)
+		_, err = curve.RandomScalarBelow(new(big.Int).Add(curve.Order(), big.NewInt(1)))
+		require.Error(t, err)
+	}
+}
+
+func TestSampleScalarBelow_Deterministic(t *testing.T) {
+	for _, curve := range allCurves() {
+		bound := new(big.Int).Lsh(big.NewInt(1), 128)
+		a, err := types.SampleScalarBelow(mrand.New(mrand.NewSource(1)), curve, bound)
+		require.NoError(t, err)
+		b, err := types.SampleScalarBelow(mrand.New(mrand.NewSource(1)), curve, bound)
+		require.NoError(t, err)
+		require.True(t, a.Eq(b))
+
+		c, err := types.SampleScalarBelow(mrand.New(mrand.NewSource(2)), curve, bound)
+		require.NoError(t, err)
+		require.False(t, a.Eq(c))
+
+		// a reader that runs dry is reported
+		_, err = types.SampleScalarBelow(bytes.NewReader(nil), curve, bound)
+		require.Error(t, err)
+	}
+}
+
+// scalarToInt returns the integer value of s. secp256k1 scalars encode
+// big-endian and ed25519 scalars little-endian.
+func scalarToInt(curve Curve, s Scalar) *big.Int {
+	enc := s.Encode()
+	if _, ok := curve.(*ed25519.CurveImpl); ok {
+		for i, j := 0, len(enc)-1; i < j; i, j = i+1, j-1 {
+			enc[i], enc[j] = enc[j], enc[i]
+		}
+	}
+	return new(big.Int).SetBytes(enc)
+}
+
+func TestPoint_EqualsBytes(t *testing.T) {
+	for _, curve := range allCurves() {
+		for i := 0; i < 16; i++ {
+			p := curve.ScalarBaseMul(curve.NewRandomScalar())
+			enc := p.Encode()
+			require.True(t, p.EqualsBytes(enc))
+			require.True(t, p.Copy().EqualsBytes(enc))
+
+			// same x, other y on secp256k1; flipped bit elsewhere on ed25519
+			flipped := append([]byte{}, enc...)
+			flipped[0] ^= 1
+			require.False(t, p.EqualsBytes(flipped))
+
+			other := curve.ScalarBaseMul(curve.NewRandomScalar())
+			require.False(t, p.EqualsBytes(other.Encode()))
+			require.Equal(t, p.Equals(other), p.EqualsBytes(other.Encode()))
+		}
+
+		G := curve.BasePoint()
+		require.True(t, G.EqualsBytes(G.Encode()))
+		require.False(t, G.EqualsBytes(nil))
+		require.False(t, G.EqualsBytes(G.Encode()[1:]))
+	}
+}
+
+func TestSumPoints(t *testing.T) {
+	for _, curve := range allCurves() {
+		// the wrapper hides the curve's own SumPoints, exercising the fallback
+		for _, c := range []Curve{curve, &recordingCurve{Curve: curve}} {
+			require.True(t, types.SumPoints(c).Equals(curve.Identity()))
+
+			points := make([]Point, 0, 8)
+			for i := 0; i < 8; i++ {
+				points = append(points, curve.ScalarBaseMul(curve.NewRandomScalar()))
+
+				expected := points[0].Copy()
+				for _, p := range points[1:] {
+					expected = expected.Add(p)
+				}
+
+				require.True(t, types.SumPoints(c, points...).Equals(expected))
+			}
+
+			// P + (-P) sums to the identity
+			P := points[0]
+			minusP := P.ScalarMul(curve.ScalarFromInt(1).Negate())
+			require.True(t, types.SumPoints(c, P, minusP).Equals(curve.Identity()))
+			require.True(t, types.SumPoints(c, P, P).Equals(P.ScalarMul(curve.ScalarFromInt(2))))
+		}
+	}
+}
+
+func TestCurve_Identity(t *testing.T) {
+	for _, curve := range allCurves() {
+		O := curve.Identity()
+		P := curve.ScalarBaseMul(curve.NewRandomScalar())
+
+		require.True(t, O.Add(P).Equals(P))
+		require.True(t, P.Add(O).Equals(P))
+		require.True(t, P.Sub(O).Equals(P))
+		require.True(t, P.Sub(P).Equals(O))
+		require.True(t, O.ScalarMul(curve.NewRandomScalar()).Equals(O))
+		require.True(t, curve.ScalarMul(curve.NewRandomScalar(), O).Equals(O))
+		require.True(t, O.Sub(P).Add(P).Equals(O))
+
+		require.True(t, O.IsZero())
+		require.True(t, P.Sub(P).IsZero())
+		require.False(t, P.IsZero())
+	}
+}
+
+func TestEd25519_IdentityEncoding(t *testing.T) {
+	curve := ed25519.NewCurve()
+	P := curve.ScalarBaseMul(curve.NewRandomScalar())
+
+	// (0, 1) encodes as y = 1 with a clear sign bit
+	canonical := make([]byte, 32)
+	canonical[0] = 1
+
+	for _, O := range []Point{curve.Identity(), P.Sub(P), types.SumPoints(curve, P, P.ScalarMul(curve.ScalarFromInt(1).Negate()))} {
+		require.Equal(t, canonical, O.Encode())
+
+		decoded, err := curve.DecodeToPoint(O.Encode())
+		require.NoError(t, err)
+		require.True(t, decoded.IsZero())
+		require.True(t, decoded.Equals(curve.Identity()))
+	}
+
+	// the all-zero encoding is a point of order 4, not the identity
+	T, err := curve.DecodeToPoint(make([]byte, 32))
+	require.NoError(t, err)
+	require.False(t, T.IsZero())
+	require.True(t, T.Add(T).Add(T).Add(T).IsZero())
+}
+
+func TestCurve_BaseMulSmall(t *testing.T) {
+	for _, curve := range allCurves() {
+		require.True(t, curve.BaseMulSmall(0).Equals(curve.Identity()))
+		require.True(t, curve.BaseMulSmall(1).Equals(curve.BasePoint()))
+
+		ks := []uint32{0xffffffff, 0x80000000, 0x12345678}
+		for k := uint32(1); k <= 64; k++ {
+			ks = append(ks, k)
+		}
+
+		for _, k := range ks {
+			require.True(t, curve.BaseMulSmall(k).Equals(curve.ScalarBaseMul(curve.ScalarFromInt(k))), "k = %d", k)
+		}
+	}
+}
+
+// TestScalar_ArithmeticMatchesBigInt cross-checks scalar arithmetic against
+// math/big reduced modulo the group order.
+func TestScalar_ArithmeticMatchesBigInt(t *testing.T) {
+	for _, curve := range allCurves() {
+		n := curve.Order()
+		for i := 0; i < 64; i++ {
+			a, b := curve.NewRandomScalar(), curve.NewRandomScalar()
+			ai, bi := scalarToInt(curve, a), scalarToInt(curve, b)
+
+			mod := func(v *big.Int) *big.Int {
+				return v.Mod(v, n)
+			}
+
+			require.Zero(t, mod(new(big.Int).Add(ai, bi)).Cmp(scalarToInt(curve, a.Add(b))))
+			require.Zero(t, mod(new(big.Int).Sub(ai, bi)).Cmp(scalarToInt(curve, a.Sub(b))))
+			require.Zero(t, mod(new(big.Int).Mul(ai, bi)).Cmp(scalarToInt(curve, a.Mul(b))))
+			require.Zero(t, mod(new(big.Int).Neg(ai)).Cmp(scalarToInt(curve, a.Negate())))
+			require.Zero(t, new(big.Int).ModInverse(ai, n).Cmp(scalarToInt(curve, a.Inverse())))
+		}
+	}
+}
+
+func TestScalar_Bytes(t *testing.T) {
+	for _, curve := range allCurves() {
+		for i := 0; i < 16; i++ {
+			s := curve.NewRandomScalar()
+			b, ok := s.(interface{ Bytes() [32]byte })
+			require.True(t, ok)
+			arr := b.Bytes()
+			require.Equal(t, s.Encode(), arr[:])
+		}
+	}
+}
+
+func TestScalar_SetUint64(t *testing.T) {
+	for _, curve := range allCurves() {
+		s := curve.NewRandomScalar()
+		for _, v := range []uint64{0, 1, 1<<32 - 1, 1 << 32, 1<<64 - 1} {
+			require.Same(t, s, s.SetUint64(v))
+			require.Zero(t, new(big.Int).SetUint64(v).Cmp(scalarToInt(curve, s)))
+
+			// v = hi * 2^32 + lo, built from freshly constructed scalars
+			shift := curve.ScalarFromInt(1 << 16).Mul(curve.ScalarFromInt(1 << 16))
+			fresh := curve.ScalarFromInt(uint32(v >> 32)).Mul(shift).Add(curve.ScalarFromInt(uint32(v)))
+			require.True(t, s.Eq(fresh))
+			require.True(t, curve.ScalarBaseMul(s).Equals(curve.ScalarBaseMul(fresh)))
+		}
+	}
+}
+
+func TestScalar_SetBytes(t *testing.T) {
+	for _, curve := range allCurves() {
+		s := curve.ScalarFromInt(7)
+		require.Error(t, s.SetBytes(make([]byte, 31)))
+		require.Error(t, s.SetBytes(make([]byte, 33)))
+		require.True(t, s.Eq(curve.ScalarFromInt(7)))
+
+		r := curve.NewRandomScalar()
+		require.NoError(t, s.SetBytes(r.Encode()))
+		require.True(t, s.Eq(r))
+
+		// n + 5 is reduced to 5
+		enc := new(big.Int).Add(curve.Order(), big.NewInt(5)).FillBytes(make([]byte, 32))
+		if _, ok := curve.(*ed25519.CurveImpl); ok {
+			slices.Reverse(enc)
+		}
+		require.NoError(t, s.SetBytes(enc))
+		require.True(t, s.Eq(curve.ScalarFromInt(5)))
+		require.Equal(t, curve.ScalarFromInt(5).Encode(), s.Encode())
+	}
+}
+
+func TestScalar_AddWithCarry(t *testing.T) {
+	for _, curve := range allCurves() {
+		one, two := curve.ScalarFromInt(1), curve.ScalarFromInt(2)
+
+		sum, reduced := one.AddWithCarry(two)
+		require.False(t, reduced)
+		require.True(t, sum.Eq(curve.ScalarFromInt(3)))
+
+		// (n-1) + 2 wraps to 1
+		sum, reduced = one.Negate().AddWithCarry(two)
+		require.True(t, reduced)
+		require.True(t, sum.Eq(one))
+
+		// (n-1) + 1 is exactly n
+		sum, reduced = one.Negate().AddWithCarry(one)
+		require.True(t, reduced)
+		require.True(t, sum.IsZero())
+
+		sum, reduced = one.Negate().AddWithCarry(curve.ScalarFromInt(0))
+		require.False(t, reduced)
+		require.True(t, sum.Eq(one.Negate()))
+
+		for i := 0; i < 64; i++ {
+			a, b := curve.NewRandomScalar(), curve.NewRandomScalar()
+			expected := new(big.Int).Add(scalarToInt(curve, a), scalarToInt(curve, b))
+			sum, reduced := a.AddWithCarry(b)
+			require.Equal(t, expected.Cmp(curve.Order()) >= 0, reduced)
+			require.True(t, sum.Eq(a.Add(b)))
+		}
+	}
+}
+
+func TestPoint_Normalized(t *testing.T) {
+	for _, curve := range allCurves() {
+		for i := 0; i < 16; i++ {
+			s := curve.NewRandomScalar()
+			points := []Point{
+				curve.ScalarBaseMul(s),
+				curve.ScalarMul(s, curve.AltBasePoint()),
+				curve.BasePoint().ScalarMul(s),
+				curve.ScalarBaseMul(s).Add(curve.AltBasePoint()),
+			}
+
+			for _, p := range points {
+				decoded, err := curve.DecodeToPoint(p.Encode())
+				require.NoError(t, err)
+				require.True(t, p.Equals(decoded))
+				require.True(t, decoded.Equals(p))
+
+				p.Normalize()
+				require.True(t, p.Equals(decoded))
+			}
+		}
+	}
+}
+
+func TestScalar_InverseConstantTime(t *testing.T) {
+	for _, curve := range allCurves() {
+		scalars := []Scalar{
+			curve.ScalarFromInt(1),
+			curve.ScalarFromInt(2),
+			curve.ScalarFromInt(1).Negate(),
+		}
+		for i := 0; i < 32; i++ {
+			scalars = append(scalars, curve.NewRandomScalar())
+		}
+
+		for _, s := range scalars {
+			inv, err := s.InverseConstantTime()
+			require.NoError(t, err)
+			require.True(t, inv.Eq(s.Inverse()))
+			require.True(t, inv.Mul(s).Eq(curve.ScalarFromInt(1)))
+		}
+
+		_, err := curve.ScalarFromInt(0).InverseConstantTime()
+		require.ErrorIs(t, err, types.ErrZeroInverse)
+	}
+}
+
+func TestScalar_Div(t *testing.T) {
+	for _, curve := range allCurves() {
+		for i := 0; i < 32; i++ {
+			a, b := curve.NewRandomScalar(), curve.NewRandomScalar()
+			q, err := a.Div(b)
+			require.NoError(t, err)
+			require.True(t, q.Mul(b).Eq(a))
+			require.True(t, q.Eq(a.Mul(b.Inverse())))
+		}
+
+		one := curve.ScalarFromInt(1)
+		q, err := curve.ScalarFromInt(6).Div(curve.ScalarFromInt(3))
+		require.NoError(t, err)
+		require.True(t, q.Eq(curve.ScalarFromInt(2)))
+
+		q, err = one.Div(one.Negate())
+		require.NoError(t, err)
+		require.True(t, q.Eq(one.Negate()))
+
+		q, err = curve.ScalarFromInt(0).Div(curve.NewRandomScalar())
+		require.NoError(t, err)
+		require.True(t, q.IsZero())
+
+		_, err = one.Div(curve.ScalarFromInt(0))
+		require.ErrorIs(t, err, types.ErrZeroInverse)
+	}
+}
+
+func TestCurve_OrderMinusOne(t *testing.T) {
+	for _, curve := range allCurves() {
+		one := curve.ScalarFromInt(1)
+		nMinusOne := new(big.Int).Sub(curve.Order(), big.NewInt(1))
+
+		last := curve.OrderMinusOne()
+		require.True(t, last.Add(one).IsZero())
+		require.True(t, last.Eq(one.Negate()))
+
+		// n is odd, so the halves either side of it sum to n - 1 and n + 1
+		half := curve.HalfOrder()
+		require.True(t, half.Add(half).Eq(last))
+		require.True(t, half.Add(one).Add(half.Add(one)).Eq(one))
+
+		// the encodings are the canonical values
+		for _, v := range []struct {
+			s        Scalar
+			expected *big.Int
+		}{
+			{last, nMinusOne},
+			{half, new(big.Int).Rsh(nMinusOne, 1)},
+		} {
+			enc := v.s.Encode()
+			if curve.Name() == "ed25519" {
+				slices.Reverse(enc)
+			}
+			require.Equal(t, v.expected.FillBytes(make([]byte, 32)), enc)
+		}
+	}
+}
+
+// TestEd25519_ScalarEncoding checks ed25519 scalars against RFC 8032: they're
+// canonical little-endian, and a signature by crypto/ed25519 verifies with
+// them.
+func TestEd25519_ScalarEncoding(t *testing.T) {
+	curve := ed25519.NewCurve()
+
+	// the group order L, from RFC 8032 section 5.1
+	const encodedL = "edd3f55c1a631258d69cf7a2def9de1400000000000000000000000000000010"
+	l, err := hex.DecodeString(encodedL)
+	require.NoError(t, err)
+
+	_, err = curve.DecodeToScalar(l)
+	require.Error(t, err)
+
+	l[0]--
+	s, err := curve.DecodeToScalar(l)
+	require.NoError(t, err)
+	require.True(t, s.Eq(curve.OrderMinusOne()))
+	require.Equal(t, l, curve.OrderMinusOne().Encode())
+
+	one := make([]byte, 32)
+	one[0] = 1
+	require.Equal(t, one, curve.ScalarFromInt(1).Encode())
+
+	// [S]B = R + [k]A, with S and k read as little-endian scalars
+	pub, priv, err := stded25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	msg := []byte("interop")
+	sig := stded25519.Sign(priv, msg)
+
+	R, err := curve.DecodeToPoint(sig[:32])
+	require.NoError(t, err)
+	A, err := curve.DecodeToPoint(pub)
+	require.NoError(t, err)
+	S, err := curve.DecodeToScalar(sig[32:])
+	require.NoError(t, err)
+	require.Equal(t, sig[32:], S.Encode())
+
+	k := curve.ReduceWide(sha512.Sum512(append(append(append([]byte{}, sig[:32]...), pub...), msg...)))
+	require.True(t, curve.ScalarBaseMul(S).Equals(R.Add(A.ScalarMul(k))))
+}
+
+func TestScalar_Normalize(t *testing.T) {
+	for _, curve := range allCurves() {
+		for _, s := range []Scalar{curve.ScalarFromInt(0), curve.ScalarFromInt(1).Negate(), curve.NewRandomScalar()} {
+			require.True(t, s.Normalize().Eq(s))
+		}
+	}
+
+	// n + 5 is only reduced by some secp256k1 backends
+	curve := secp256k1.NewCurve()
+	five := curve.ScalarFromInt(5)
+	be := new(big.Int).Add(curve.Order(), big.NewInt(5)).FillBytes(make([]byte, 32))
+
+	// DecodeToScalar rejects it as non-canonical
+	_, err := curve.DecodeToScalar(be)
+	require.Error(t, err)
+
+	var le [32]byte
+	for i := range le {
+		le[i] = be[31-i]
+	}
+	require.True(t, curve.ScalarFromBytes(le).Normalize().Eq(five))
+}
+
+func TestCurve_DecodeToPoints(t *testing.T) {
+	for _, curve := range allCurves() {
+		decoders := map[string]func([]byte, int) ([]Point, error){
+			"curve": curve.DecodeToPoints,
+			"generic": func(in []byte, count int) ([]Point, error) {
+				return types.DecodeToPoints(curve, in, count)
+			},
+		}
+
+		points := make([]Point, 8)
+		var enc []byte
+		for i := range points {
+			points[i] = curve.ScalarBaseMul(curve.NewRandomScalar())
+			enc = append(enc, points[i].Encode()...)
+		}
+
+		// a point that fails to decode on secp256k1, and a small order
+		// point that decodes but doesn't validate on ed25519
+		invalid := make([]byte, curve.CompressedPointSize())
+		if curve.Name() == "secp256k1" {
+			invalid[0] = 0x05
+		}
+
+		corrupted := bytes.Clone(enc)
+		copy(corrupted[4*len(invalid):], invalid)
+
+		for name, decode := range decoders {
+			decoded, err := decode(enc, len(points))
+			require.NoError(t, err, name)
+			require.Len(t, decoded, len(points))
+			for i := range points {
+				require.True(t, points[i].Equals(decoded[i]), name)
+				require.NoError(t, curve.ValidatePoint(decoded[i]), name)
+			}
+
+			decoded, err = decode(nil, 0)
+			require.NoError(t, err, name)
+			require.Empty(t, decoded, name)
+
+			_, err = decode(corrupted, len(points))
+			var decodeErr *types.PointDecodeError
+			require.ErrorAs(t, err, &decodeErr, name)
+			require.Equal(t, 4, decodeErr.Index, name)
+
+			for _, count := range []int{-1, 7, 9} {
+				_, err = decode(enc, count)
+				require.Error(t, err, name)
+				require.False(t, errors.As(err, &decodeErr), name)
+			}
+		}
+	}
+}
+
+func TestCurve_AddBaseMul(t *testing.T) {
+	for _, curve := range allCurves() {
+		gen, err := newGeneratorCurve(curve, curve.AltBasePoint(), curve.BasePoint())
+		require.NoError(t, err)
+
+		for _, c := range []Curve{curve, gen} {
+			P := c.ScalarMul(c.NewRandomScalar(), c.AltBasePoint())
+			scalars := []Scalar{c.ScalarFromInt(0), c.ScalarFromInt(1), c.ScalarFromInt(1).Negate()}
+			for i := 0; i < 8; i++ {
+				scalars = append(scalars, c.NewRandomScalar())
+			}
+
+			for _, s := range scalars {
+				sG := c.ScalarBaseMul(s)
+				require.True(t, c.AddBaseMul(P, s).Equals(P.Add(sG)))
+				require.True(t, c.SubBaseMul(P, s).Equals(P.Sub(sG)))
+
+				// from and to the identity
+				require.True(t, c.AddBaseMul(c.Identity(), s).Equals(sG))
+				require.True(t, c.SubBaseMul(sG, s).IsZero())
+			}
+		}
+	}
+}
+
+func TestCurve_IsGenerator(t *testing.T) {
+	curves := allCurves()
+	for i, curve := range curves {
+		other := curves[(i+1)%len(curves)]
+
+		// the generators swapped, which a generatorCurve must report too
+		gen, err := newGeneratorCurve(curve, curve.AltBasePoint(), curve.BasePoint())
+		require.NoError(t, err)
+
+		for _, c := range []Curve{curve, gen} {
+			G, H := c.BasePoint(), c.AltBasePoint()
+
+			isG, isH := c.IsGenerator(G)
+			require.True(t, isG)
+			require.False(t, isH)
+
+			isG, isH = c.IsGenerator(H)
+			require.False(t, isG)
+			require.True(t, isH)
+
+			// equal points, not the same object
+			isG, isH = c.IsGenerator(G.Add(c.Identity()))
+			require.True(t, isG)
+			require.False(t, isH)
+
+			for _, p := range []Point{
+				c.ScalarBaseMul(c.NewRandomScalar()),
+				c.Identity(),
+				G.Add(G),
+				other.BasePoint(),
+				other.AltBasePoint(),
+			} {
+				isG, isH = c.IsGenerator(p)
+				require.False(t, isG)
+				require.False(t, isH)
+			}
+		}
+	}
+}
+
+func TestCurve_Equal(t *testing.T) {
+	secp := secp256k1.NewCurve()
+	ed := ed25519.NewCurve()
+
+	require.True(t, secp.Equal(secp256k1.NewCurve()))
+	require.True(t, ed.Equal(ed25519.NewCurve()))
+	require.False(t, secp.Equal(ed))
+	require.False(t, ed.Equal(secp))
+	require.False(t, secp.Equal(nil))
+
+	// curves with other generators are different, and compare the same way
+	// from either side
+	for _, curve := range []Curve{secp, ed} {
+		h := curve.ScalarBaseMul(curve.NewRandomScalar())
+		custom, err := newGeneratorCurve(curve, curve.BasePoint(), h)
+		require.NoError(t, err)
+		require.False(t, curve.Equal(custom))
+		require.False(t, custom.Equal(curve))
+
+		same, err := newGeneratorCurve(curve, curve.BasePoint(), curve.AltBasePoint())
+		require.NoError(t, err)
+		require.True(t, curve.Equal(same))
+		require.True(t, same.Equal(curve))
+	}
+}
+
+func TestScalarMulBlinded(t *testing.T) {
+	for _, curve := range allCurves() {
+		for i := 0; i < 64; i++ {
+			s := curve.NewRandomScalar()
+			p := curve.ScalarBaseMul(curve.NewRandomScalar())
+
+			res, err := types.ScalarMulBlinded(curve, s, p, rand.Reader)
+			require.NoError(t, err)
+			require.True(t, curve.ScalarMul(s, p).Equals(res))
+		}
+
+		p := curve.ScalarBaseMul(curve.NewRandomScalar())
+		res, err := types.ScalarMulBlinded(curve, curve.ScalarFromInt(0), p, rand.Reader)
+		require.NoError(t, err)
+		require.True(t, res.IsZero())
+
+		res, err = types.ScalarMulBlinded(curve, curve.ScalarFromInt(3), curve.Identity(), rand.Reader)
+		require.NoError(t, err)
+		require.True(t, res.IsZero())
+
+		// the scalar isn't modified
+		s := curve.NewRandomScalar()
+		enc := s.Encode()
+		_, err = types.ScalarMulBlinded(curve, s, p, rand.Reader)
+		require.NoError(t, err)
+		require.Equal(t, enc, s.Encode())
+
+		_, err = types.ScalarMulBlinded(curve, s, p, bytes.NewReader(nil))
+		require.Error(t, err)
+	}
+}
+
+func TestCurve_DecodeToPointUnchecked(t *testing.T) {
+	for _, curve := range allCurves() {
+		for i := 0; i < 16; i++ {
+			p := curve.ScalarBaseMul(curve.NewRandomScalar())
+			decoded, err := curve.DecodeToPointUnchecked(p.Encode())
+			require.NoError(t, err, curve.Name())
+			require.True(t, p.Equals(decoded), curve.Name())
+			require.Equal(t, p.Encode(), decoded.Encode(), curve.Name())
+		}
+
+		// the length is still checked
+		enc := curve.BasePoint().Encode()
+		for _, in := range [][]byte{nil, enc[:len(enc)-1], append(enc, 0)} {
+			_, err := curve.DecodeToPointUnchecked(in)
+			require.Error(t, err, curve.Name())
+		}
+	}
+
+	// and so is the format byte on secp256k1
+	enc := secp256k1.NewCurve().BasePoint().Encode()
+	enc[0] = 0x04
+	_, err := secp256k1.NewCurve().DecodeToPointUnchecked(enc)
+	require.Error(t, err)
+
+	// a non-canonical encoding of the ed25519 identity, y = p + 1, is only
+	// rejected by DecodeToPoint
+	nonCanonical := make([]byte, 32)
+	nonCanonical[0] = 0xee
+	for i := 1; i < 31; i++ {
+		nonCanonical[i] = 0xff
+	}
+	nonCanonical[31] = 0x7f
+
+	curve := ed25519.NewCurve()
+	_, err = curve.DecodeToPoint(nonCanonical)
+	require.Error(t, err)
+	p, err := curve.DecodeToPointUnchecked(nonCanonical)
+	require.NoError(t, err)
+	require.True(t, p.IsZero())
+}
+
+func TestCurve_ValidateSecretStrength(t *testing.T) {
+	for _, curve := range allCurves() {
+		// little-endian encodings of the order and of small values and
+		// their negations
+		le := func(v *big.Int) [32]byte {
+			var b [32]byte
+			v.FillBytes(b[:])
+			slices.Reverse(b[:])
+			return b
+		}
+
+		order := curve.Order()
+		weak := []*big.Int{
+			big.NewInt(0),
+			big.NewInt(1),
+			big.NewInt(1<<32 - 1),
+			new(big.Int).Sub(order, big.NewInt(1)),
+			new(big.Int).Sub(order, big.NewInt(1<<32-1)),
+			order,
+			new(big.Int).Add(order, big.NewInt(1)),
+		}
+		for _, v := range weak {
+			require.Error(t, curve.ValidateSecretStrength(le(v)), "%s: %v", curve.Name(), v)
+		}
+
+		for _, v := range []*big.Int{
+			big.NewInt(1 << 32),
+			new(big.Int).Sub(order, big.NewInt(1<<32)),
+		} {
+			require.NoError(t, curve.ValidateSecretStrength(le(v)), "%s: %v", curve.Name(), v)
+		}
+
+		s := curve.NewRandomScalar()
+		var b [32]byte
+		copy(b[:], s.Encode())
+		if curve.Name() == "secp256k1" {
+			slices.Reverse(b[:])
+		}
+		require.NoError(t, curve.ValidateSecretStrength(b))
+	}
+}
@@ -0,0 +1,72 @@
+package dleq
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+)
+
+const deterministicDomain = "go-dleq/deterministic-proof/v1"
+
+// NewProofDeterministic returns a new proof for the given secret on the
+// given curves, like NewProof, but derives every blinder and nonce from the
+// secret and additionalEntropy instead of the system random number generator.
+// The same inputs always produce the same proof, and the scalars remain
+// unpredictable to anyone who doesn't know the secret.
+//
+// The derivation is bound to the curves, so the same secret and entropy used
+// with a different pair of curves yields unrelated nonces.
+func NewProofDeterministic(curveA, curveB Curve, x [32]byte, additionalEntropy []byte) (*Proof, error) {
+	source := newHMACScalarSource(curveA, curveB, x, additionalEntropy)
+	defer source.zeroize()
+	proof, _, err := newProof(curveA, curveB, x, source.next, nil)
+	return proof, err
+}
+
+// hmacScalarSource derives scalars as HMAC-SHA512(key, counter), where key
+// is HMAC-SHA512 keyed by the secret over the domain, the curves and the
+// additional entropy. Each output is reduced from 64 bytes so it's uniform
+// modulo the curve order.
+type hmacScalarSource struct {
+	key     []byte
+	counter uint64
+}
+
+func newHMACScalarSource(curveA, curveB Curve, x [32]byte, additionalEntropy []byte) *hmacScalarSource {
+	mac := hmac.New(sha512.New, x[:])
+	writeLengthPrefixed := func(b []byte) {
+		var l [8]byte
+		binary.BigEndian.PutUint64(l[:], uint64(len(b)))
+		mac.Write(l[:])
+		mac.Write(b)
+	}
+
+	writeLengthPrefixed([]byte(deterministicDomain))
+	for _, curve := range []Curve{curveA, curveB} {
+		writeLengthPrefixed(curve.BasePoint().Encode())
+		writeLengthPrefixed(curve.AltBasePoint().Encode())
+	}
+	writeLengthPrefixed(additionalEntropy)
+
+	return &hmacScalarSource{
+		key: mac.Sum(nil),
+	}
+}
+
+func (s *hmacScalarSource) next(curve Curve) (Scalar, error) {
+	var ctr [8]byte
+	binary.BigEndian.PutUint64(ctr[:], s.counter)
+	s.counter++
+
+	mac := hmac.New(sha512.New, s.key)
+	mac.Write(ctr[:])
+
+	var wide [64]byte
+	mac.Sum(wide[:0])
+	defer clear(wide[:])
+	return curve.ReduceWide(wide), nil
+}
+
+func (s *hmacScalarSource) zeroize() {
+	clear(s.key)
+}
@@ -1,6 +1,9 @@
 package dleq
 
 import (
+	"bytes"
+	"encoding/hex"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -39,7 +42,7 @@ func TestGenerateCommitments(t *testing.T) {
 	curve := secp256k1.NewCurve()
 	x, err := generateRandomBits(curve.BitSize())
 	require.NoError(t, err)
-	commitments, err := generateCommitments(curve, x[:], curve.BitSize())
+	commitments, err := generateCommitments(curve, x[:], curve.BitSize(), systemRandom)
 	require.NoError(t, err)
 	require.Equal(t, int(curve.BitSize()), len(commitments))
 
@@ -52,16 +55,16 @@ func TestGenerateRingSignature(t *testing.T) {
 	curve := secp256k1.NewCurve()
 	x, err := generateRandomBits(curve.BitSize())
 	require.NoError(t, err)
-	commitmentsA, err := generateCommitments(curve, x[:], curve.BitSize())
+	commitmentsA, err := generateCommitments(curve, x[:], curve.BitSize(), systemRandom)
 	require.NoError(t, err)
 	require.Equal(t, int(curve.BitSize()), len(commitmentsA))
-	commitmentsB, err := generateCommitments(curve, x[:], curve.BitSize())
+	commitmentsB, err := generateCommitments(curve, x[:], curve.BitSize(), systemRandom)
 	require.NoError(t, err)
 	require.Equal(t, int(curve.BitSize()), len(commitmentsB))
 
 	for i := 0; i < int(curve.BitSize()); i++ {
 		bit := getBit(x[:], uint64(i))
-		_, err := generateRingSignature(curve, curve, bit, commitmentsA[i], commitmentsB[i])
+		_, err := generateRingSignature(curve, curve, bit, commitmentsA[i], commitmentsB[i], systemRandom)
 		require.NoError(t, err)
 	}
 }
@@ -76,3 +79,320 @@ func TestProveAndVerify(t *testing.T) {
 	err = proof.Verify(curveA, curveB)
 	require.NoError(t, err)
 }
+
+// recordingCurve wraps a curve and keeps every scalar it creates from bytes
+// or randomness, so tests can inspect them after they've been used.
+type recordingCurve struct {
+	Curve
+	fromBytes []Scalar
+	random    []Scalar
+}
+
+func (c *recordingCurve) ScalarFromBytes(b [32]byte) Scalar {
+	s := c.Curve.ScalarFromBytes(b)
+	c.fromBytes = append(c.fromBytes, s)
+	return s
+}
+
+func (c *recordingCurve) NewRandomScalar() Scalar {
+	s := c.Curve.NewRandomScalar()
+	c.random = append(c.random, s)
+	return s
+}
+
+func TestNewProof_Zeroize(t *testing.T) {
+	curveA := &recordingCurve{Curve: secp256k1.NewCurve()}
+	curveB := &recordingCurve{Curve: ed25519.NewCurve()}
+	x, err := GenerateSecretForCurves(curveA.Curve, curveB.Curve)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	// the secret scalars have been wiped
+	require.Len(t, curveA.fromBytes, 1)
+	require.Len(t, curveB.fromBytes, 1)
+	require.True(t, curveA.fromBytes[0].IsZero())
+	require.True(t, curveB.fromBytes[0].IsZero())
+
+	// the only random scalars left intact are the ones published in the
+	// proof, the blinders and ring signature nonces are wiped
+	published := make(map[Scalar]bool)
+	for _, p := range proof.proofs {
+		require.Nil(t, p.commitmentA.blinder)
+		require.Nil(t, p.commitmentB.blinder)
+		published[p.ringSig.a0] = true
+		published[p.ringSig.a1] = true
+		published[p.ringSig.b0] = true
+		published[p.ringSig.b1] = true
+	}
+
+	for _, curve := range []*recordingCurve{curveA, curveB} {
+		require.NotEmpty(t, curve.random)
+		for _, s := range curve.random {
+			require.True(t, s.IsZero() || published[s])
+		}
+	}
+
+	// the caller's copy is untouched and the proof still verifies
+	require.NotEqual(t, [32]byte{}, x)
+	require.NoError(t, proof.Verify(curveA.Curve, curveB.Curve))
+}
+
+func TestScalar_Zeroize(t *testing.T) {
+	for _, curve := range allCurves() {
+		s := curve.NewRandomScalar()
+		require.False(t, s.IsZero())
+		s.Zeroize()
+		require.True(t, s.IsZero())
+		require.True(t, s.Eq(curve.ScalarFromInt(0)))
+	}
+}
+
+func TestNewProofDeterministic(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+
+	entropy := []byte("entropy")
+	proof, err := NewProofDeterministic(curveA, curveB, x, entropy)
+	require.NoError(t, err)
+	require.NoError(t, proof.Verify(curveA, curveB))
+
+	// same inputs, same proof
+	again, err := NewProofDeterministic(curveA, curveB, x, entropy)
+	require.NoError(t, err)
+	require.Equal(t, proof.Serialize(), again.Serialize())
+
+	// different entropy, different blinders
+	other, err := NewProofDeterministic(curveA, curveB, x, []byte("other entropy"))
+	require.NoError(t, err)
+	require.NoError(t, other.Verify(curveA, curveB))
+	require.True(t, other.CommitmentA.Equals(proof.CommitmentA))
+	require.NotEqual(t, proof.Serialize(), other.Serialize())
+	for i := range proof.proofs {
+		require.False(t, proof.proofs[i].commitmentA.commitment.Equals(other.proofs[i].commitmentA.commitment))
+	}
+
+	// the caller's secret isn't consumed
+	_, err = NewProofDeterministic(curveA, curveB, x, nil)
+	require.NoError(t, err)
+	require.Equal(t, proof.CommitmentA.Encode(), curveA.ScalarBaseMul(curveA.ScalarFromBytes(x)).Encode())
+}
+
+func TestNewProofDeterministic_BoundToCurves(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+
+	// reusing the secret and entropy on another curve pair must not reuse
+	// the blinders derived for the first pair
+	sourceAB := newHMACScalarSource(curveA, curveB, x, nil)
+	sourceAA := newHMACScalarSource(curveA, curveA, x, nil)
+	sAB, err := sourceAB.next(curveA)
+	require.NoError(t, err)
+	sAA, err := sourceAA.next(curveA)
+	require.NoError(t, err)
+	require.False(t, sAB.Eq(sAA))
+
+	// and successive draws differ
+	sAB2, err := sourceAB.next(curveA)
+	require.NoError(t, err)
+	require.False(t, sAB.Eq(sAB2))
+}
+
+func TestNewProofWithBits(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+
+	proof, bits, err := NewProofWithBits(curveA, curveB, x)
+	require.NoError(t, err)
+	require.NoError(t, proof.Verify(curveA, curveB))
+	require.Len(t, bits, int(min(curveA.BitSize(), curveB.BitSize())))
+
+	var reconstructed [32]byte
+	for i, bit := range bits {
+		require.LessOrEqual(t, bit, byte(1))
+		reconstructed[i/8] |= bit << (i % 8)
+	}
+	require.Equal(t, x, reconstructed)
+
+	// the reconstructed secret is the discrete log of the proof's commitment
+	require.True(t, proof.CommitmentA.Equals(curveA.ScalarBaseMul(curveA.ScalarFromBytes(reconstructed))))
+}
+
+func TestNewProof_Endianness(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+
+	// 0x0f1e2d...: small enough for both curves when read big-endian
+	be, err := hex.DecodeString("0f1e2d3c4b5a69788796a5b4c3d2e1f00112233445566778899aabbccddeeff0")
+	require.NoError(t, err)
+	var x [32]byte
+	copy(x[:], be)
+
+	proof, err := NewProofBE(curveA, curveB, x)
+	require.NoError(t, err)
+	require.NoError(t, proof.Verify(curveA, curveB))
+
+	// secp256k1 DecodeToScalar is big-endian
+	xA, err := curveA.DecodeToScalar(x[:])
+	require.NoError(t, err)
+	require.True(t, proof.CommitmentA.Equals(curveA.ScalarBaseMul(xA)))
+
+	// the same value read little-endian is too large
+	_, err = NewProofLE(curveA, curveB, x)
+	require.Error(t, err)
+
+	// reversed, the same integer is accepted as little-endian
+	var le [32]byte
+	for i := range x {
+		le[i] = x[31-i]
+	}
+
+	proofLE, err := NewProofLE(curveA, curveB, le)
+	require.NoError(t, err)
+	require.True(t, proofLE.CommitmentA.Equals(curveA.ScalarBaseMul(curveA.ScalarFromBytes(le))))
+	require.True(t, proofLE.CommitmentA.Equals(proof.CommitmentA))
+
+	// ed25519 DecodeToScalar is little-endian
+	xB, err := curveB.DecodeToScalar(le[:])
+	require.NoError(t, err)
+	require.True(t, proofLE.CommitmentB.Equals(curveB.ScalarBaseMul(xB)))
+	require.True(t, proof.CommitmentB.Equals(proofLE.CommitmentB))
+}
+
+func TestGenerateSecretForCurves_RejectsDegenerate(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+
+	zero := make([]byte, 32)
+	one := make([]byte, 32)
+	one[0] = 1
+	valid, err := hex.DecodeString("0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f00")
+	require.NoError(t, err)
+
+	r := bytes.NewReader(append(append(zero, one...), valid...))
+	x, err := generateSecretForCurves(curveA, curveB, r)
+	require.NoError(t, err)
+	require.Equal(t, valid, x[:])
+	require.Zero(t, r.Len())
+
+	// the reader running out is an error, not a degenerate secret
+	_, err = generateSecretForCurves(curveA, curveB, bytes.NewReader(zero))
+	require.Error(t, err)
+}
+
+func TestIsDegenerateSecret(t *testing.T) {
+	for _, curve := range allCurves() {
+		one := curve.ScalarFromInt(1)
+		for _, s := range []Scalar{curve.ScalarFromInt(0), one, one.Negate()} {
+			// x is little-endian
+			var x [32]byte
+			scalarToInt(curve, s).FillBytes(x[:])
+			slices.Reverse(x[:])
+			require.True(t, isDegenerateSecret(curve, x))
+		}
+
+		var x [32]byte
+		x[0] = 2
+		require.False(t, isDegenerateSecret(curve, x))
+	}
+}
+
+func TestNewProofWithProgress(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+
+	var calls []int
+	total := 0
+	proof, err := NewProofWithProgress(curveA, curveB, x, func(done, tot int) {
+		calls = append(calls, done)
+		total = tot
+	})
+	require.NoError(t, err)
+	require.NoError(t, proof.Verify(curveA, curveB))
+
+	require.Equal(t, int(min(curveA.BitSize(), curveB.BitSize())), total)
+	require.Len(t, calls, total)
+	for i, done := range calls {
+		require.Equal(t, i+1, done)
+	}
+
+	// a nil callback is allowed
+	_, err = NewProofWithProgress(curveA, curveB, x, nil)
+	require.NoError(t, err)
+}
+
+// TestNewProof_BoundarySecrets proves secrets at the edges of the bit
+// decomposition, where the ring signatures of most bits take the same branch.
+func TestNewProof_BoundarySecrets(t *testing.T) {
+	for _, curves := range [][2]Curve{
+		{secp256k1.NewCurve(), ed25519.NewCurve()},
+		{ed25519.NewCurve(), secp256k1.NewCurve()},
+	} {
+		curveA, curveB := curves[0], curves[1]
+		bits := int(min(curveA.BitSize(), curveB.BitSize()))
+
+		var one, top, allOnes [32]byte
+		one[0] = 1
+		top[(bits-1)/8] = 1 << ((bits - 1) % 8)
+		for i := 0; i < bits; i++ {
+			allOnes[i/8] |= 1 << (i % 8)
+		}
+
+		for _, tc := range []struct {
+			name string
+			x    [32]byte
+			ones int
+		}{
+			{"1", one, 1},
+			{"2^(bits-1)", top, 1},
+			{"2^bits - 1", allOnes, bits},
+		} {
+			proof, proofBits, err := NewProofWithBits(curveA, curveB, tc.x)
+			require.NoError(t, err, tc.name)
+			require.NoError(t, proof.Verify(curveA, curveB), tc.name)
+
+			ones := 0
+			for _, bit := range proofBits {
+				ones += int(bit)
+			}
+			require.Equal(t, tc.ones, ones, tc.name)
+		}
+
+		// the public keys of zero are the identity, which proves nothing
+		_, err := NewProof(curveA, curveB, [32]byte{})
+		require.Error(t, err)
+
+		proof, err := NewProof(curveA, curveB, one)
+		require.NoError(t, err)
+		proof.CommitmentA = curveA.Identity()
+		require.Error(t, proof.Verify(curveA, curveB))
+	}
+}
+
+func TestValidateSecretForCurves(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	require.NoError(t, ValidateSecretForCurves(curveA, curveB, x))
+
+	var zero, one [32]byte
+	one[0] = 1
+	require.ErrorContains(t, ValidateSecretForCurves(curveA, curveB, zero), "zero")
+	require.ErrorContains(t, ValidateSecretForCurves(curveA, curveB, one), "weak")
+
+	// 2^252 is below both orders but too wide for the proof
+	var wide [32]byte
+	wide[31] = 0x10
+	require.NoError(t, curveA.ValidateSecretStrength(wide))
+	require.ErrorContains(t, ValidateSecretForCurves(curveA, curveB, wide), "under 252 bits")
+}
@@ -0,0 +1,66 @@
+package dleq
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"fmt"
+	"io"
+)
+
+// Dump writes the public parts of the proof to w as labeled lines of text,
+// for debugging and support: the curves, the number of bits, the public
+// keys and signatures, and for every bit a SHA-256 prefix of each
+// commitment and the ring signature's challenges. The blinders are never
+// written; a decoded proof doesn't have them anyway. The format is meant for
+// people and may change.
+func (p *Proof) Dump(w io.Writer, curveA, curveB Curve) error {
+	bw := bufio.NewWriter(w)
+	idA, idB := p.CurveIDs()
+	fmt.Fprintf(bw, "curve A:        %s (id %d)\n", curveA.Name(), idA)
+	fmt.Fprintf(bw, "curve B:        %s (id %d)\n", curveB.Name(), idB)
+	fmt.Fprintf(bw, "bits:           %d (expected %d)\n", len(p.proofs), min(curveA.BitSize(), curveB.BitSize()))
+	fmt.Fprintf(bw, "challenge bits: %d\n", p.challengeBits)
+	fmt.Fprintf(bw, "public key A:   %s\n", dumpPoint(p.CommitmentA))
+	fmt.Fprintf(bw, "public key B:   %s\n", dumpPoint(p.CommitmentB))
+	fmt.Fprintf(bw, "signature A:    %x\n", p.signatureA.inner)
+	fmt.Fprintf(bw, "signature B:    %x\n", p.signatureB.inner)
+
+	for i, bp := range p.proofs {
+		fmt.Fprintf(bw, "bit %d: commitment A %s commitment B %s challenge A %s challenge B %s\n",
+			i,
+			dumpCommitmentHash(bp.commitmentA.commitment),
+			dumpCommitmentHash(bp.commitmentB.commitment),
+			dumpScalar(bp.ringSig.eCurveA),
+			dumpScalar(bp.ringSig.eCurveB),
+		)
+	}
+
+	return bw.Flush()
+}
+
+func dumpPoint(p Point) string {
+	if p == nil {
+		return "<nil>"
+	}
+
+	return fmt.Sprintf("%x", p.Encode())
+}
+
+// dumpCommitmentHash returns the first 8 bytes of the SHA-256 digest of the
+// point's encoding, which is enough to tell commitments apart.
+func dumpCommitmentHash(p Point) string {
+	if p == nil {
+		return "<nil>"
+	}
+
+	digest := sha256.Sum256(p.Encode())
+	return fmt.Sprintf("sha256:%x", digest[:8])
+}
+
+func dumpScalar(s Scalar) string {
+	if s == nil {
+		return "<nil>"
+	}
+
+	return fmt.Sprintf("%x", s.Encode())
+}
@@ -0,0 +1,62 @@
+package dleq
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+func TestProof_Dump(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, proof.Dump(&buf, curveA, curveB))
+	out := buf.String()
+
+	for _, label := range []string{
+		"curve A:        secp256k1",
+		"curve B:        ed25519",
+		"bits:           252 (expected 252)",
+		"challenge bits: ",
+		"public key A:   " + hex.EncodeToString(proof.CommitmentA.Encode()),
+		"public key B:   " + hex.EncodeToString(proof.CommitmentB.Encode()),
+		"signature A:    ",
+		"signature B:    ",
+	} {
+		require.Contains(t, out, label)
+	}
+
+	bitLines := 0
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "bit ") {
+			require.Contains(t, line, "commitment A sha256:")
+			require.Contains(t, line, "challenge B ")
+			bitLines++
+		}
+	}
+	require.Equal(t, len(proof.proofs), bitLines)
+	require.Contains(t, out, fmt.Sprintf("bit %d: ", len(proof.proofs)-1))
+
+	// no secret material: the witness isn't in the proof, and NewProof
+	// drops the blinders, but check anyway
+	require.NotContains(t, out, hex.EncodeToString(x[:]))
+	for _, bp := range proof.proofs {
+		for _, c := range []commitment{bp.commitmentA, bp.commitmentB} {
+			if c.blinder != nil {
+				require.NotContains(t, out, hex.EncodeToString(c.blinder.Encode()))
+			}
+		}
+	}
+}
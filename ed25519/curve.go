@@ -1,12 +1,17 @@
 package ed25519
 
 import (
+	"bytes"
 	"crypto/rand"
 	"crypto/sha512"
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
+	"math/big"
+	"math/bits"
+	"slices"
 
 	"github.com/pokt-network/go-dleq/types"
 	"golang.org/x/crypto/sha3"
@@ -18,6 +23,9 @@ type Curve = types.Curve
 type Point = types.Point
 type Scalar = types.Scalar
 
+// order is the order of the prime-order subgroup, 2^252 + 27742317777372353535851937790883648493.
+var order, _ = new(big.Int).SetString("1000000000000000000000000000000014def9dea2f79cd65812631a5cf5d3ed", 16)
+
 type CurveImpl struct {
 	altBasePoint Point
 }
@@ -45,6 +53,14 @@ func altBasePoint() Point {
 	}
 }
 
+func (*CurveImpl) Name() string {
+	return "ed25519"
+}
+
+func (c *CurveImpl) Equal(other types.Curve) bool {
+	return types.CurvesEqual(c, other)
+}
+
 func (*CurveImpl) BitSize() uint64 {
 	return 252
 }
@@ -53,6 +69,28 @@ func (*CurveImpl) CompressedPointSize() int {
 	return 32
 }
 
+func (*CurveImpl) ScalarSize() int {
+	return 32
+}
+
+func (*CurveImpl) Order() *big.Int {
+	return new(big.Int).Set(order)
+}
+
+func (c *CurveImpl) OrderMinusOne() Scalar {
+	return c.ScalarFromInt(1).Negate()
+}
+
+func (c *CurveImpl) HalfOrder() Scalar {
+	var b [32]byte
+	new(big.Int).Rsh(order, 1).FillBytes(b[:])
+	slices.Reverse(b[:])
+	return c.ScalarFromBytes(b)
+}
+
+// DecodeToPoint decodes a 32-byte point. Unlike edwards25519's SetBytes,
+// it rejects non-canonical encodings, ie. a y coordinate of p or more or a
+// negative zero x, so every point has exactly one accepted encoding.
 func (*CurveImpl) DecodeToPoint(in []byte) (Point, error) {
 	cp := make([]byte, len(in))
 	copy(cp, in)
@@ -61,11 +99,36 @@ func (*CurveImpl) DecodeToPoint(in []byte) (Point, error) {
 		return nil, err
 	}
 
+	if !bytes.Equal(p.Bytes(), in) {
+		return nil, errors.New("invalid point; non-canonical encoding")
+	}
+
 	return &PointImpl{
 		inner: p,
 	}, nil
 }
 
+// DecodeToPointUnchecked skips the canonical encoding check of
+// DecodeToPoint, which costs a field inversion. edwards25519's SetBytes
+// can't skip its own on-curve check, so points off the curve are still
+// rejected.
+func (*CurveImpl) DecodeToPointUnchecked(in []byte) (Point, error) {
+	p, err := new(edwards25519.Point).SetBytes(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PointImpl{
+		inner: p,
+	}, nil
+}
+
+func (c *CurveImpl) DecodeToPoints(in []byte, count int) ([]Point, error) {
+	return types.DecodeToPoints(c, in, count)
+}
+
+// DecodeToScalar decodes a 32-byte little-endian scalar, as in RFC 8032. It
+// rejects non-canonical encodings, ie. values of the group order or more.
 func (*CurveImpl) DecodeToScalar(in []byte) (Scalar, error) {
 	if len(in) != 32 {
 		return nil, errors.New("invalid scalar length")
@@ -89,27 +152,75 @@ func (*CurveImpl) BasePoint() Point {
 	}
 }
 
+func (*CurveImpl) Identity() Point {
+	return &PointImpl{
+		inner: edwards25519.NewIdentityPoint(),
+	}
+}
+
+// SumPoints returns the sum of the given points, accumulated in place.
+func (*CurveImpl) SumPoints(points ...Point) Point {
+	sum := edwards25519.NewIdentityPoint()
+	for _, p := range points {
+		pp, ok := p.(*PointImpl)
+		if !ok {
+			panic("invalid point; type is not *ed25519.PointImpl")
+		}
+
+		sum.Add(sum, pp.inner)
+	}
+
+	return &PointImpl{
+		inner: sum,
+	}
+}
+
 func (c *CurveImpl) AltBasePoint() Point {
 	return c.altBasePoint
 }
 
+func (c *CurveImpl) IsGenerator(p Point) (isG, isH bool) {
+	pp, ok := p.(*PointImpl)
+	if !ok {
+		return false, false
+	}
+
+	return pp.inner.Equal(edwards25519.NewGeneratorPoint()) == 1, c.altBasePoint.Equals(p)
+}
+
+// NewRandomScalar returns a uniformly random scalar in [1, l).
 func (*CurveImpl) NewRandomScalar() Scalar {
+	return newRandomScalar(rand.Reader)
+}
+
+// newRandomScalar reduces 64 bytes read from r modulo l, which is uniform
+// but for a bias of about 2^-259, so nothing overflows. A zero result is
+// discarded and read again.
+func newRandomScalar(r io.Reader) Scalar {
 	var b [64]byte
-	_, err := rand.Read(b[:])
-	if err != nil {
-		panic(err)
-	}
+	for {
+		_, err := io.ReadFull(r, b[:])
+		if err != nil {
+			panic(err)
+		}
 
-	s, err := new(edwards25519.Scalar).SetUniformBytes(b[:])
-	if err != nil {
-		panic(err)
-	}
+		s, err := new(edwards25519.Scalar).SetUniformBytes(b[:])
+		if err != nil {
+			panic(err)
+		}
 
-	return &ScalarImpl{
-		inner: s,
+		if s.Equal(edwards25519.NewScalar()) == 0 {
+			return &ScalarImpl{
+				inner: s,
+			}
+		}
 	}
 }
 
+func (c *CurveImpl) RandomScalarBelow(bound *big.Int) (Scalar, error) {
+	return types.SampleScalarBelow(rand.Reader, c, bound)
+}
+
 func (*CurveImpl) ScalarFromBytes(b [32]byte) Scalar {
 	s, err := new(edwards25519.Scalar).SetCanonicalBytes(b[:])
 	if err != nil {
@@ -131,16 +242,33 @@ func (c *CurveImpl) ScalarFromInt(in uint32) Scalar {
 	return c.ScalarFromBytes(bFull)
 }
 
-func (*CurveImpl) HashToScalar(in []byte) (Scalar, error) {
+func (c *CurveImpl) ValidateSecretStrength(secret [32]byte) error {
+	return types.ValidateSecretStrength(c, secret)
+}
+
+func (c *CurveImpl) HashToScalar(in []byte) (Scalar, error) {
 	h := sha3.Sum512(in)
-	s, err := new(edwards25519.Scalar).SetUniformBytes(h[:])
+	return c.ReduceWide(h), nil
+}
+
+// ReduceWide reduces the little-endian integer b modulo the group order.
+func (*CurveImpl) ReduceWide(b [64]byte) Scalar {
+	s, err := new(edwards25519.Scalar).SetUniformBytes(b[:])
 	if err != nil {
 		panic(err)
 	}
 
 	return &ScalarImpl{
 		inner: s,
-	}, nil
+	}
+}
+
+// SplitReduce reduces each little-endian 32-byte half of b modulo the group order.
+func (c *CurveImpl) SplitReduce(b [64]byte) (Scalar, Scalar) {
+	var lo, hi [64]byte
+	copy(lo[:32], b[:32])
+	copy(hi[:32], b[32:])
+	return c.ReduceWide(lo), c.ReduceWide(hi)
 }
 
 func (*CurveImpl) ScalarBaseMul(s Scalar) Point {
@@ -154,6 +282,30 @@ func (*CurveImpl) ScalarBaseMul(s Scalar) Point {
 	}
 }
 
+func (c *CurveImpl) AddBaseMul(p Point, s Scalar) Point {
+	return p.Add(c.ScalarBaseMul(s))
+}
+
+func (c *CurveImpl) SubBaseMul(p Point, s Scalar) Point {
+	return p.Sub(c.ScalarBaseMul(s))
+}
+
+// BaseMulSmall computes k*G by double-and-add over the bits of k.
+func (*CurveImpl) BaseMulSmall(k uint32) Point {
+	g := edwards25519.NewGeneratorPoint()
+	r := edwards25519.NewIdentityPoint()
+	for i := bits.Len32(k) - 1; i >= 0; i-- {
+		r.Add(r, r)
+		if k>>i&1 == 1 {
+			r.Add(r, g)
+		}
+	}
+
+	return &PointImpl{
+		inner: r,
+	}
+}
+
 func (*CurveImpl) ScalarMul(s Scalar, p Point) Point {
 	ss, ok := s.(*ScalarImpl)
 	if !ok {
@@ -170,6 +322,54 @@ func (*CurveImpl) ScalarMul(s Scalar, p Point) Point {
 	}
 }
 
+func (*CurveImpl) NewFixedBaseTable(p Point) types.FixedBaseTable {
+	pp, ok := p.(*PointImpl)
+	if !ok {
+		panic("invalid point; type is not *ed25519.PointImpl")
+	}
+
+	table := new(fixedBase)
+	base := new(edwards25519.Point).Set(pp.inner)
+	for i := range table {
+		window := &table[i]
+		window[0].Set(base)
+		for j := 1; j < len(window); j++ {
+			window[j].Add(&window[j-1], base)
+		}
+
+		// the next window's base is 16 times this one's
+		base.Add(&window[len(window)-1], base)
+	}
+
+	return table
+}
+
+// fixedBase holds multiples of a point for windowed multiplication: entry j
+// of window i is (j+1) * 16^i * P, for the 64 4-bit windows of a scalar.
+type fixedBase [64][15]edwards25519.Point
+
+// ScalarMul isn't constant time, unlike ScalarMul on the curve: the
+// additions depend on the scalar's digits.
+func (t *fixedBase) ScalarMul(s Scalar) Point {
+	ss, ok := s.(*ScalarImpl)
+	if !ok {
+		panic("invalid scalar; type is not *ed25519.ScalarImpl")
+	}
+
+	k := ss.inner.Bytes()
+	result := edwards25519.NewIdentityPoint()
+	for i := range t {
+		d := k[i/2] >> (4 * (i % 2)) & 0xf
+		if d != 0 {
+			result.Add(result, &t[i][d-1])
+		}
+	}
+
+	return &PointImpl{
+		inner: result,
+	}
+}
+
 func (*CurveImpl) Sign(s Scalar, p Point) ([]byte, error) {
 	ss, ok := s.(*ScalarImpl)
 	if !ok {
@@ -235,6 +435,42 @@ func (*CurveImpl) Verify(pubkey, msgPoint Point, sig []byte) bool {
 	return res.Equal(R) == 1
 }
 
+// ValidatePoint checks that the point is an ed25519 point, is not the
+// identity and lies in the prime-order subgroup, ie. has no small-order
+// component.
+func (*CurveImpl) ValidatePoint(p Point) error {
+	pp, ok := p.(*PointImpl)
+	if !ok || pp.inner == nil {
+		return errors.New("invalid point; type is not *ed25519.PointImpl")
+	}
+
+	identity := edwards25519.NewIdentityPoint()
+	if pp.inner.Equal(identity) == 1 {
+		return errors.New("point is the identity")
+	}
+
+	// (l-1)*P + P = l*P, which is the identity iff P is in the prime-order subgroup.
+	minusOne := new(edwards25519.Scalar).Negate(scalarOne())
+	lP := new(edwards25519.Point).ScalarMult(minusOne, pp.inner)
+	lP.Add(lP, pp.inner)
+	if lP.Equal(identity) != 1 {
+		return errors.New("point is not in the prime-order subgroup")
+	}
+
+	return nil
+}
+
+func scalarOne() *edwards25519.Scalar {
+	var b [32]byte
+	b[0] = 1
+	s, err := new(edwards25519.Scalar).SetCanonicalBytes(b[:])
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
 type ScalarImpl struct {
 	inner *edwards25519.Scalar
 }
@@ -250,6 +486,24 @@ func (s *ScalarImpl) Add(b Scalar) Scalar {
 	}
 }
 
+func (s *ScalarImpl) AddWithCarry(b Scalar) (Scalar, bool) {
+	sum := s.Add(b).(*ScalarImpl)
+
+	// both inputs are below the order, so the sum wrapped iff it's now
+	// smaller than either of them
+	return sum, lessThanLE(sum.inner.Bytes(), s.inner.Bytes())
+}
+
+// lessThanLE reports whether a < b for 256-bit little-endian integers, in
+// constant time.
+func lessThanLE(a, b []byte) bool {
+	var borrow uint64
+	for i := 0; i < 32; i += 8 {
+		_, borrow = bits.Sub64(binary.LittleEndian.Uint64(a[i:]), binary.LittleEndian.Uint64(b[i:]), borrow)
+	}
+	return borrow == 1
+}
+
 func (s *ScalarImpl) Sub(b Scalar) Scalar {
 	ss, ok := b.(*ScalarImpl)
 	if !ok {
@@ -284,10 +538,46 @@ func (s *ScalarImpl) Inverse() Scalar {
 	}
 }
 
+// InverseConstantTime is Inverse, which is already constant time, with an
+// error for zero.
+func (s *ScalarImpl) InverseConstantTime() (Scalar, error) {
+	if s.IsZero() {
+		return nil, types.ErrZeroInverse
+	}
+
+	return s.Inverse(), nil
+}
+
+func (s *ScalarImpl) Div(b Scalar) (Scalar, error) {
+	ss, ok := b.(*ScalarImpl)
+	if !ok {
+		panic("invalid scalar; type is not *ed25519.ScalarImpl")
+	}
+
+	if ss.IsZero() {
+		return nil, types.ErrZeroInverse
+	}
+
+	r := new(edwards25519.Scalar).Invert(ss.inner)
+	return &ScalarImpl{
+		inner: r.Multiply(s.inner, r),
+	}, nil
+}
+
+// Encode returns the canonical 32-byte little-endian encoding of the scalar,
+// as in RFC 8032.
 func (s *ScalarImpl) Encode() []byte {
 	return s.inner.Bytes()
 }
 
+// Bytes is like Encode, but returns the little-endian encoding as an array,
+// so it doesn't need to be allocated on the heap.
+func (s *ScalarImpl) Bytes() [32]byte {
+	var b [32]byte
+	copy(b[:], s.inner.Bytes())
+	return b
+}
+
 func (s *ScalarImpl) Eq(b Scalar) bool {
 	ss, ok := b.(*ScalarImpl)
 	if !ok {
@@ -300,6 +590,48 @@ func (s *ScalarImpl) IsZero() bool {
 	return s.inner.Equal(new(edwards25519.Scalar)) == 1
 }
 
+// Normalize returns a copy of the scalar; edwards25519 scalars are always
+// reduced.
+func (s *ScalarImpl) Normalize() Scalar {
+	return &ScalarImpl{
+		inner: new(edwards25519.Scalar).Set(s.inner),
+	}
+}
+
+func (s *ScalarImpl) Zeroize() {
+	s.inner.Set(edwards25519.NewScalar())
+}
+
+func (s *ScalarImpl) SetUint64(v uint64) Scalar {
+	var b [32]byte
+	binary.LittleEndian.PutUint64(b[:8], v)
+	if s.inner == nil {
+		s.inner = new(edwards25519.Scalar)
+	}
+
+	// a uint64 is always below l, so it's canonical
+	_, err := s.inner.SetCanonicalBytes(b[:])
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// SetBytes sets the scalar to the little-endian b, reduced modulo l.
+func (s *ScalarImpl) SetBytes(b []byte) error {
+	if len(b) != 32 {
+		return errors.New("invalid scalar length")
+	}
+
+	var wide [64]byte
+	copy(wide[:], b)
+	if s.inner == nil {
+		s.inner = new(edwards25519.Scalar)
+	}
+	_, err := s.inner.SetUniformBytes(wide[:])
+	return err
+}
+
 type PointImpl struct {
 	inner *edwards25519.Point
 }
@@ -353,13 +685,29 @@ func (p *PointImpl) Encode() []byte {
 	return p.inner.Bytes()
 }
 
+// Normalize is a no-op. edwards25519 keeps points in extended coordinates
+// and normalizes them itself when encoding or comparing.
+func (*PointImpl) Normalize() {}
+
+// IsZero reports whether the point is the identity, (0, 1), which encodes
+// as 0x01 followed by 31 zero bytes. Note the all-zero encoding is not the
+// identity but a point of order 4.
 func (p *PointImpl) IsZero() bool {
-	var zero [32]byte
-	zp, err := new(edwards25519.Point).SetBytes(zero[:])
-	if err != nil {
-		panic(err)
+	return p.inner.Equal(edwards25519.NewIdentityPoint()) == 1
+}
+
+var _ types.PointEncodeInto = &PointImpl{}
+
+func (p *PointImpl) EncodeInto(dst []byte) (int, error) {
+	if len(dst) < 32 {
+		return 0, types.ErrBufferTooSmall
 	}
-	return p.inner.Equal(zp) == 1
+
+	return copy(dst, p.inner.Bytes()), nil
+}
+
+func (p *PointImpl) EqualsBytes(enc []byte) bool {
+	return bytes.Equal(p.inner.Bytes(), enc)
 }
 
 func (p *PointImpl) Equals(other Point) bool {
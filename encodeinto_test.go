@@ -0,0 +1,95 @@
+package dleq
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/types"
+)
+
+// encodeViaIntoOrFallback writes p's encoding into dst, using EncodeInto
+// when the point supports it.
+func encodeViaIntoOrFallback(p Point, dst []byte) (int, error) {
+	if e, ok := p.(types.PointEncodeInto); ok {
+		return e.EncodeInto(dst)
+	}
+
+	enc := p.Encode()
+	if len(dst) < len(enc) {
+		return 0, types.ErrBufferTooSmall
+	}
+	return copy(dst, enc), nil
+}
+
+// plainPoint hides any optional interfaces of the wrapped point.
+type plainPoint struct {
+	Point
+}
+
+func TestPoint_EncodeInto(t *testing.T) {
+	for _, curve := range allCurves() {
+		size := curve.CompressedPointSize()
+		points := []Point{
+			curve.BasePoint(),
+			curve.Identity(),
+			curve.ScalarBaseMul(curve.NewRandomScalar()),
+		}
+
+		for _, p := range points {
+			_, ok := p.(types.PointEncodeInto)
+			require.True(t, ok)
+
+			for _, pp := range []Point{p, plainPoint{p}} {
+				// a larger buffer is only written at the start
+				dst := bytes.Repeat([]byte{0xaa}, size+8)
+				n, err := encodeViaIntoOrFallback(pp, dst)
+				require.NoError(t, err)
+				require.Equal(t, size, n)
+				require.Equal(t, p.Encode(), dst[:n])
+				require.Equal(t, bytes.Repeat([]byte{0xaa}, 8), dst[n:])
+			}
+		}
+	}
+}
+
+func TestPoint_EncodeInto_Undersized(t *testing.T) {
+	for _, curve := range allCurves() {
+		p := curve.ScalarBaseMul(curve.NewRandomScalar())
+		for _, pp := range []Point{p, plainPoint{p}} {
+			for _, l := range []int{0, 1, curve.CompressedPointSize() - 1} {
+				dst := bytes.Repeat([]byte{0xaa}, l)
+				n, err := encodeViaIntoOrFallback(pp, dst)
+				require.ErrorIs(t, err, types.ErrBufferTooSmall)
+				require.Zero(t, n)
+				require.Equal(t, bytes.Repeat([]byte{0xaa}, l), dst)
+			}
+
+			n, err := encodeViaIntoOrFallback(pp, nil)
+			require.ErrorIs(t, err, types.ErrBufferTooSmall)
+			require.Zero(t, n)
+		}
+	}
+}
+
+// TestPoint_EncodeLength checks that every encoding is exactly
+// CompressedPointSize bytes, which encodeViaIntoOrFallback and the
+// serialization rely on.
+func TestPoint_EncodeLength(t *testing.T) {
+	for _, curve := range allCurves() {
+		points := []Point{
+			curve.Identity(),
+			curve.BasePoint(),
+			curve.AltBasePoint(),
+			curve.BasePoint().Sub(curve.BasePoint()),
+		}
+		for i := 0; i < 64; i++ {
+			points = append(points, curve.ScalarBaseMul(curve.NewRandomScalar()))
+		}
+
+		for _, p := range points {
+			require.Len(t, p.Encode(), curve.CompressedPointSize(), "%s point %x", curve.Name(), p.Encode())
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package dleq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+func TestCurve_NewFixedBaseTable(t *testing.T) {
+	for _, curve := range allCurves() {
+		for _, p := range []Point{
+			curve.BasePoint(),
+			curve.AltBasePoint(),
+			curve.ScalarBaseMul(curve.NewRandomScalar()),
+			curve.Identity(),
+		} {
+			table := curve.NewFixedBaseTable(p)
+
+			scalars := []Scalar{
+				curve.ScalarFromInt(0),
+				curve.ScalarFromInt(1),
+				curve.ScalarFromInt(16),
+				curve.OrderMinusOne(),
+				curve.HalfOrder(),
+			}
+			for i := 0; i < 16; i++ {
+				scalars = append(scalars, curve.NewRandomScalar())
+			}
+
+			for _, s := range scalars {
+				expected := curve.ScalarMul(s, p)
+				got := table.ScalarMul(s)
+				require.True(t, expected.Equals(got), "%s %x * %x", curve.Name(), s.Encode(), p.Encode())
+				require.Equal(t, expected.Encode(), got.Encode())
+				require.Equal(t, expected.IsZero(), got.IsZero())
+			}
+		}
+	}
+}
+
+func BenchmarkFixedBaseTable_ScalarMul(b *testing.B) {
+	for _, curve := range []Curve{secp256k1.NewCurve(), ed25519.NewCurve()} {
+		table := curve.NewFixedBaseTable(curve.AltBasePoint())
+		s := curve.NewRandomScalar()
+
+		b.Run(curve.Name()+"/ScalarMul", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = curve.ScalarMul(s, curve.AltBasePoint())
+			}
+		})
+
+		b.Run(curve.Name()+"/Table", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = table.ScalarMul(s)
+			}
+		})
+
+		b.Run(curve.Name()+"/NewTable", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = curve.NewFixedBaseTable(curve.AltBasePoint())
+			}
+		})
+	}
+}
+
+func TestProofVerifier(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	verifier := NewProofVerifier(curveA, curveB)
+
+	for i := 0; i < 2; i++ {
+		x, err := GenerateSecretForCurves(curveA, curveB)
+		require.NoError(t, err)
+		proof, err := NewProof(curveA, curveB, x)
+		require.NoError(t, err)
+		require.NoError(t, verifier.Verify(proof))
+
+		// a corrupted multiple of H fails like it does with Verify
+		ringSig := &proof.proofs[i].ringSig
+		ringSig.b0 = ringSig.b0.Add(curveB.ScalarFromInt(1))
+		require.Error(t, proof.Verify(curveA, curveB))
+		require.EqualError(t, verifier.Verify(proof), proof.Verify(curveA, curveB).Error())
+	}
+}
+
+func TestFixedBaseCurve(t *testing.T) {
+	for _, inner := range allCurves() {
+		gen, err := newGeneratorCurve(inner, inner.BaseMulSmall(3), inner.ScalarBaseMul(inner.NewRandomScalar()))
+		require.NoError(t, err)
+
+		// generatorCurve returns copies of its generators, which the
+		// wrapper must still multiply with its tables
+		for _, curve := range []Curve{inner, gen} {
+			c := newFixedBaseCurve(curve)
+			require.Same(t, c.BasePoint(), c.BasePoint())
+			require.Same(t, c.AltBasePoint(), c.AltBasePoint())
+
+			s := curve.NewRandomScalar()
+			p := curve.ScalarBaseMul(curve.NewRandomScalar())
+			require.True(t, c.ScalarMul(s, c.BasePoint()).Equals(curve.ScalarBaseMul(s)))
+			require.True(t, c.ScalarMul(s, c.AltBasePoint()).Equals(curve.ScalarMul(s, curve.AltBasePoint())))
+			require.True(t, c.ScalarMul(s, p).Equals(curve.ScalarMul(s, p)))
+			require.True(t, c.ScalarBaseMul(s).Equals(curve.ScalarBaseMul(s)))
+			require.True(t, c.AddBaseMul(p, s).Equals(curve.AddBaseMul(p, s)))
+			require.True(t, c.SubBaseMul(p, s).Equals(curve.SubBaseMul(p, s)))
+		}
+	}
+}
+
+func TestProofVerifier_Generators(t *testing.T) {
+	secp := secp256k1.NewCurve()
+	ed := ed25519.NewCurve()
+	curveA, curveB, err := newGeneratorCurves(secp, ed,
+		secp.BaseMulSmall(3), secp.ScalarBaseMul(secp.NewRandomScalar()),
+		ed.BaseMulSmall(3), ed.ScalarBaseMul(ed.NewRandomScalar()))
+	require.NoError(t, err)
+
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	verifier := NewProofVerifier(curveA, curveB)
+	require.NoError(t, verifier.Verify(proof))
+
+	ringSig := &proof.proofs[0].ringSig
+	ringSig.a1 = ringSig.a1.Add(curveA.ScalarFromInt(1))
+	require.EqualError(t, verifier.Verify(proof), proof.Verify(curveA, curveB).Error())
+}
+
+func BenchmarkProofVerifier(b *testing.B) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	proof, err := NewProof(curveA, curveB, x)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("Verify", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			err := proof.Verify(curveA, curveB)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	verifier := NewProofVerifier(curveA, curveB)
+	b.Run("ProofVerifier", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			err := verifier.Verify(proof)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("NewProofVerifier", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = NewProofVerifier(curveA, curveB)
+		}
+	})
+}
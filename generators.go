@@ -0,0 +1,162 @@
+package dleq
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pokt-network/go-dleq/types"
+)
+
+// NewProofWithGenerators is like NewProof, but commits to x using the given
+// generators instead of each curve's BasePoint and AltBasePoint: the public
+// points are x*gA and x*gB, and the bit commitments are blinded with hA and
+// hB. The generators must be valid points and the discrete log of h with
+// respect to g must be unknown, as with the default generators.
+//
+// The proof must be verified with VerifyWithGenerators and the same
+// generators.
+func NewProofWithGenerators(curveA, curveB Curve, gA, hA, gB, hB Point, x [32]byte) (*Proof, error) {
+	genA, genB, err := newGeneratorCurves(curveA, curveB, gA, hA, gB, hB)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, _, err := newProof(genA, genB, x, systemRandom, nil)
+	return proof, err
+}
+
+// VerifyWithGenerators verifies a proof made by NewProofWithGenerators with
+// the same generators.
+func (p *Proof) VerifyWithGenerators(curveA, curveB Curve, gA, hA, gB, hB Point) error {
+	genA, genB, err := newGeneratorCurves(curveA, curveB, gA, hA, gB, hB)
+	if err != nil {
+		return err
+	}
+
+	return p.Verify(genA, genB)
+}
+
+func newGeneratorCurves(curveA, curveB Curve, gA, hA, gB, hB Point) (Curve, Curve, error) {
+	genA, err := newGeneratorCurve(curveA, gA, hA)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid generators on curve A: %w", err)
+	}
+
+	genB, err := newGeneratorCurve(curveB, gB, hB)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid generators on curve B: %w", err)
+	}
+
+	return genA, genB, nil
+}
+
+// generatorCurve is a curve whose BasePoint and AltBasePoint are replaced by
+// caller-supplied generators.
+//
+// The commitment signatures in a proof are made with Sign, which for the
+// underlying curves proves knowledge of the key with respect to their own
+// base point. generatorCurve replaces them with a Schnorr signature with
+// respect to g, encoded as R || s.
+type generatorCurve struct {
+	Curve
+	g, h Point
+}
+
+func newGeneratorCurve(curve Curve, g, h Point) (*generatorCurve, error) {
+	err := curve.ValidatePoint(g)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base point: %w", err)
+	}
+
+	err = curve.ValidatePoint(h)
+	if err != nil {
+		return nil, fmt.Errorf("invalid alt base point: %w", err)
+	}
+
+	if g.Equals(h) {
+		return nil, errors.New("base point and alt base point must differ")
+	}
+
+	return &generatorCurve{
+		Curve: curve,
+		g:     g.Copy(),
+		h:     h.Copy(),
+	}, nil
+}
+
+func (c *generatorCurve) Equal(other Curve) bool {
+	return types.CurvesEqual(c, other)
+}
+
+func (c *generatorCurve) BasePoint() Point {
+	return c.g.Copy()
+}
+
+func (c *generatorCurve) AltBasePoint() Point {
+	return c.h.Copy()
+}
+
+// IsGenerator compares encodings, which also tells points of other curves
+// apart without knowing the underlying point type.
+func (c *generatorCurve) IsGenerator(p Point) (isG, isH bool) {
+	return p.EqualsBytes(c.g.Encode()), p.EqualsBytes(c.h.Encode())
+}
+
+func (c *generatorCurve) ScalarBaseMul(s Scalar) Point {
+	return c.Curve.ScalarMul(s, c.g)
+}
+
+func (c *generatorCurve) AddBaseMul(p Point, s Scalar) Point {
+	return p.Add(c.ScalarBaseMul(s))
+}
+
+func (c *generatorCurve) SubBaseMul(p Point, s Scalar) Point {
+	return p.Sub(c.ScalarBaseMul(s))
+}
+
+func (c *generatorCurve) BaseMulSmall(k uint32) Point {
+	return c.Curve.ScalarMul(c.Curve.ScalarFromInt(k), c.g)
+}
+
+func (c *generatorCurve) Sign(s Scalar, p Point) ([]byte, error) {
+	k := c.Curve.NewRandomScalar()
+	defer k.Zeroize()
+
+	R := c.ScalarBaseMul(k)
+	e, err := c.challenge(c.ScalarBaseMul(s), p, R)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := R.Encode()
+	return append(sig, k.Add(e.Mul(s)).Encode()...), nil
+}
+
+func (c *generatorCurve) Verify(pubkey, msgPoint Point, sig []byte) bool {
+	pointSize := c.Curve.CompressedPointSize()
+	if len(sig) != pointSize+c.Curve.ScalarSize() {
+		return false
+	}
+
+	R, err := c.Curve.DecodeToPoint(sig[:pointSize])
+	if err != nil {
+		return false
+	}
+
+	s, err := c.Curve.DecodeToScalar(sig[pointSize:])
+	if err != nil {
+		return false
+	}
+
+	e, err := c.challenge(pubkey, msgPoint, R)
+	if err != nil {
+		return false
+	}
+
+	// s*g == R + e*X
+	return c.ScalarBaseMul(s).Equals(R.Add(pubkey.ScalarMul(e)))
+}
+
+func (c *generatorCurve) challenge(pubkey, msgPoint, R Point) (Scalar, error) {
+	return hashToScalar(c.Curve, c.g, pubkey, msgPoint, R)
+}
@@ -0,0 +1,66 @@
+package dleq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+func TestNewProofWithGenerators(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+
+	// generators with discrete logs unknown to the prover
+	gA := curveA.ScalarMul(curveA.NewRandomScalar(), curveA.AltBasePoint())
+	hA := curveA.ScalarBaseMul(curveA.NewRandomScalar())
+	gB := curveB.ScalarMul(curveB.NewRandomScalar(), curveB.AltBasePoint())
+	hB := curveB.ScalarBaseMul(curveB.NewRandomScalar())
+
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+
+	proof, err := NewProofWithGenerators(curveA, curveB, gA, hA, gB, hB, x)
+	require.NoError(t, err)
+	require.True(t, proof.CommitmentA.Equals(curveA.ScalarMul(curveA.ScalarFromBytes(x), gA)))
+	require.True(t, proof.CommitmentB.Equals(curveB.ScalarMul(curveB.ScalarFromBytes(x), gB)))
+
+	err = proof.VerifyWithGenerators(curveA, curveB, gA, hA, gB, hB)
+	require.NoError(t, err)
+
+	// round-trips through serialization
+	res := new(Proof)
+	err = res.Deserialize(curveA, curveB, proof.Serialize())
+	require.NoError(t, err)
+	err = res.VerifyWithGenerators(curveA, curveB, gA, hA, gB, hB)
+	require.NoError(t, err)
+
+	// the proof doesn't verify with other generators
+	err = proof.Verify(curveA, curveB)
+	require.Error(t, err)
+	err = proof.VerifyWithGenerators(curveA, curveB, gA, hA, gB, curveB.AltBasePoint())
+	require.Error(t, err)
+	err = proof.VerifyWithGenerators(curveA, curveB, curveA.BasePoint(), hA, gB, hB)
+	require.Error(t, err)
+}
+
+func TestNewProofWithGenerators_Invalid(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+
+	gA, hA := curveA.BasePoint(), curveA.AltBasePoint()
+	gB, hB := curveB.BasePoint(), curveB.AltBasePoint()
+
+	_, err = NewProofWithGenerators(curveA, curveB, gA, gA, gB, hB, x)
+	require.Error(t, err)
+
+	_, err = NewProofWithGenerators(curveA, curveB, curveA.Identity(), hA, gB, hB, x)
+	require.Error(t, err)
+
+	_, err = NewProofWithGenerators(curveA, curveB, gA, hA, gB, curveB.Identity(), x)
+	require.Error(t, err)
+}
@@ -0,0 +1,161 @@
+package dleq
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// interopFixture is a serialized proof produced by some implementation of
+// the transcript in TRANSCRIPT.md. Fixtures from other implementations are
+// added to testdata/interop and verified as is. The fixture generated by
+// this package is in testdata/golden and also records the inputs, so the
+// proof is regenerated and compared byte for byte to catch transcript
+// changes.
+type interopFixture struct {
+	Description string `json:"description"`
+	Generator   string `json:"generator"`
+	CurveA      string `json:"curve_a"`
+	CurveB      string `json:"curve_b"`
+	PublicA     string `json:"public_a"`
+	PublicB     string `json:"public_b"`
+	Proof       string `json:"proof"`
+
+	// only for fixtures generated by go-dleq
+	Secret  string `json:"secret,omitempty"`
+	Entropy string `json:"entropy,omitempty"`
+}
+
+func curveByName(t *testing.T, name string) Curve {
+	for _, c := range allCurves() {
+		if c.Name() == name {
+			return c
+		}
+	}
+
+	t.Fatalf("unknown curve %q", name)
+	return nil
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func readFixture(t *testing.T, file string) interopFixture {
+	raw, err := os.ReadFile(file)
+	require.NoError(t, err)
+
+	var f interopFixture
+	require.NoError(t, json.Unmarshal(raw, &f))
+	return f
+}
+
+// verifyFixture deserializes the fixture's proof and verifies it against
+// its public keys, returning the proof and its serialization.
+func verifyFixture(t *testing.T, f interopFixture) (curveA, curveB Curve, proof *Proof, ser []byte) {
+	curveA, curveB = curveByName(t, f.CurveA), curveByName(t, f.CurveB)
+	pointA, err := curveA.DecodeToPoint(decodeHex(t, f.PublicA))
+	require.NoError(t, err)
+	pointB, err := curveB.DecodeToPoint(decodeHex(t, f.PublicB))
+	require.NoError(t, err)
+
+	ser = decodeHex(t, f.Proof)
+	proof = new(Proof)
+	require.NoError(t, proof.Deserialize(curveA, curveB, ser))
+	require.NoError(t, proof.VerifyAgainst(curveA, curveB, pointA, pointB))
+	return curveA, curveB, proof, ser
+}
+
+// TestInteropFixtures verifies the proofs generated by other
+// implementations in testdata/interop.
+func TestInteropFixtures(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "interop", "*.json"))
+	require.NoError(t, err)
+	if len(files) == 0 {
+		t.Skip("no fixtures from other implementations in testdata/interop yet")
+	}
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			f := readFixture(t, file)
+			require.NotEqual(t, "go-dleq", f.Generator, "fixtures generated by go-dleq belong in testdata/golden")
+			verifyFixture(t, f)
+		})
+	}
+}
+
+// TestTranscriptGolden verifies the proof generated by this package and
+// regenerates it from the recorded inputs, to catch transcript changes. It
+// doesn't test interoperability.
+func TestTranscriptGolden(t *testing.T) {
+	f := readFixture(t, filepath.Join("testdata", "golden", "go-dleq_secp256k1_ed25519.json"))
+	require.Equal(t, "go-dleq", f.Generator)
+	curveA, curveB, _, ser := verifyFixture(t, f)
+
+	var x [32]byte
+	copy(x[:], decodeHex(t, f.Secret))
+	regenerated, err := NewProofDeterministic(curveA, curveB, x, decodeHex(t, f.Entropy))
+	require.NoError(t, err)
+	require.Equal(t, ser, regenerated.Serialize())
+}
+
+// TestProofWireLayout locks the serialization of TRANSCRIPT.md field by
+// field against the go-dleq fixture, and the encodings built on it:
+// MarshalBinary, WriteTo and the protobuf message, whose expected bytes are
+// in testdata/golden.
+func TestProofWireLayout(t *testing.T) {
+	f := readFixture(t, filepath.Join("testdata", "golden", "go-dleq_secp256k1_ed25519.json"))
+	_, _, proof, ser := verifyFixture(t, f)
+
+	// enc(X_A) || enc(X_B) || n
+	require.Equal(t, decodeHex(t, f.PublicA), ser[:33])
+	require.Equal(t, decodeHex(t, f.PublicB), ser[33:65])
+	require.Equal(t, byte(252), ser[65])
+	require.Len(t, proof.proofs, 252)
+
+	// enc(C_A_i) || enc(C_B_i) || e_A || e_B || a_0 || a_1 || b_0 || b_1
+	off := 66
+	for _, bp := range proof.proofs {
+		b := ser[off : off+257]
+		require.Equal(t, bp.commitmentA.commitment.Encode(), b[:33])
+		require.Equal(t, bp.commitmentB.commitment.Encode(), b[33:65])
+		sig := bp.ringSig
+		for j, s := range []Scalar{sig.eCurveA, sig.eCurveB, sig.a0, sig.a1, sig.b0, sig.b1} {
+			require.Equal(t, s.Encode(), b[65+32*j:65+32*(j+1)])
+		}
+		off += 257
+	}
+
+	// len(sig_A) || sig_A || len(sig_B) || sig_B, a DER signature on
+	// secp256k1 and R || s on ed25519
+	sigA := ser[off+1 : off+1+int(ser[off])]
+	require.Equal(t, byte(0x30), sigA[0])
+	off += 1 + len(sigA)
+	require.Equal(t, byte(64), ser[off])
+	require.Len(t, ser, off+1+64)
+
+	bin, err := proof.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, append([]byte{CurveIDSecp256k1, CurveIDEd25519}, ser...), bin)
+
+	var buf bytes.Buffer
+	_, err = proof.WriteTo(&buf)
+	require.NoError(t, err)
+	require.Equal(t, ser, buf.Bytes())
+
+	golden, err := os.ReadFile(filepath.Join("testdata", "golden", "go-dleq_secp256k1_ed25519.pb.hex"))
+	require.NoError(t, err)
+	m, err := proof.ToProto()
+	require.NoError(t, err)
+	enc, err := m.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, strings.TrimSpace(string(golden)), hex.EncodeToString(enc))
+}
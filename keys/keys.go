@@ -0,0 +1,215 @@
+// Package keys provides public and private key types wrapping the points and
+// scalars of a curve, so that keys can't be confused with other points and
+// scalars such as commitments and blinders.
+package keys
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/pokt-network/go-dleq/types"
+	"golang.org/x/crypto/sha3"
+)
+
+// FingerprintLength is the length in bytes of a public key's fingerprint.
+const FingerprintLength = 20
+
+// PublicKey is a validated public key on a curve.
+type PublicKey struct {
+	curve types.Curve
+	point types.Point
+}
+
+// NewPublicKey returns a public key for the given point. The point must be a
+// valid non-identity point in the curve's prime-order subgroup.
+func NewPublicKey(curve types.Curve, point types.Point) (*PublicKey, error) {
+	err := curve.ValidatePoint(point)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+
+	return &PublicKey{
+		curve: curve,
+		point: point.Copy(),
+	}, nil
+}
+
+// ParsePublicKey decodes and validates a compressed public key.
+func ParsePublicKey(curve types.Curve, in []byte) (*PublicKey, error) {
+	point, err := curve.DecodeToPoint(in)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode public key: %w", err)
+	}
+
+	return NewPublicKey(curve, point)
+}
+
+// Curve returns the curve the key is on.
+func (k *PublicKey) Curve() types.Curve {
+	return k.curve
+}
+
+// Point returns a copy of the key's point.
+func (k *PublicKey) Point() types.Point {
+	return k.point.Copy()
+}
+
+// Encode returns the compressed encoding of the key.
+func (k *PublicKey) Encode() []byte {
+	return k.point.Encode()
+}
+
+// Equals reports whether both keys are the same point.
+func (k *PublicKey) Equals(other *PublicKey) bool {
+	return k.point.Equals(other.point)
+}
+
+// Verify verifies a signature over msg made with the corresponding private key.
+func (k *PublicKey) Verify(msg types.Point, sig []byte) bool {
+	return k.curve.Verify(k.point, msg, sig)
+}
+
+// Fingerprint returns a short identifier for the key: the last 20 bytes of
+// the SHA3-256 hash of its compressed encoding. It's specific to this
+// package and is not the address of the key on any chain.
+func (k *PublicKey) Fingerprint() []byte {
+	h := sha3.Sum256(k.point.Encode())
+	return h[len(h)-FingerprintLength:]
+}
+
+// DeriveChild derives the public key of child index, the parent key plus
+// childTweak(index) times the base point. It's the public counterpart of
+// PrivateKey.DeriveChild, so anyone with the parent public key, eg. a
+// watch-only wallet holding its encoding, can derive the child public keys.
+// Children derive further children the same way.
+func (k *PublicKey) DeriveChild(index uint32) (*PublicKey, error) {
+	tweak, err := childTweak(k, index)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPublicKey(k.curve, k.curve.AddBaseMul(k.point, tweak))
+}
+
+// DeriveChildPoint derives the child public point of index from the parent
+// public point and its compressed encoding, as PublicKey.DeriveChild does.
+// The encoding must be that of parentPub, and parentPub must be a valid
+// public key on curve.
+func DeriveChildPoint(curve types.Curve, parentPub types.Point, parentPubEncoding []byte, index uint32) (types.Point, error) {
+	if !parentPub.EqualsBytes(parentPubEncoding) {
+		return nil, errors.New("parent public key encoding doesn't match the point")
+	}
+
+	parent, err := NewPublicKey(curve, parentPub)
+	if err != nil {
+		return nil, err
+	}
+
+	child, err := parent.DeriveChild(index)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.point, nil
+}
+
+// childTweak returns H(parent || index), the non-hardened tweak of child
+// index, with the index big-endian and H the curve's HashToScalar.
+func childTweak(parent *PublicKey, index uint32) (types.Scalar, error) {
+	preimage := binary.BigEndian.AppendUint32(parent.point.Encode(), index)
+	return parent.curve.HashToScalar(preimage)
+}
+
+// PrivateKey is a validated private key on a curve.
+type PrivateKey struct {
+	curve  types.Curve
+	scalar types.Scalar
+	public *PublicKey
+}
+
+// GeneratePrivateKey returns a new random private key.
+func GeneratePrivateKey(curve types.Curve) (*PrivateKey, error) {
+	return NewPrivateKey(curve, curve.NewRandomScalar())
+}
+
+// NewPrivateKey returns a private key for the given scalar. The scalar must
+// be non-zero and reduced modulo the curve order.
+func NewPrivateKey(curve types.Curve, s types.Scalar) (*PrivateKey, error) {
+	if s.IsZero() {
+		return nil, errors.New("invalid private key: scalar is zero")
+	}
+
+	// adding zero reduces the scalar, so this catches unreduced values
+	if !s.Add(curve.ScalarFromInt(0)).Eq(s) {
+		return nil, errors.New("invalid private key: scalar is not below the curve order")
+	}
+
+	public, err := NewPublicKey(curve, curve.ScalarBaseMul(s))
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateKey{
+		curve:  curve,
+		scalar: s.Add(curve.ScalarFromInt(0)),
+		public: public,
+	}, nil
+}
+
+// ParsePrivateKey decodes a private key. The encoding must be canonical, ie.
+// encode a value below the curve order.
+func ParsePrivateKey(curve types.Curve, in []byte) (*PrivateKey, error) {
+	s, err := curve.DecodeToScalar(in)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode private key: %w", err)
+	}
+
+	// some curves reduce when decoding, so check the value round-trips
+	if !bytes.Equal(s.Add(curve.ScalarFromInt(0)).Encode(), in) {
+		return nil, errors.New("invalid private key: scalar is not below the curve order")
+	}
+
+	return NewPrivateKey(curve, s)
+}
+
+// Curve returns the curve the key is on.
+func (k *PrivateKey) Curve() types.Curve {
+	return k.curve
+}
+
+// Scalar returns the key's scalar. The caller must not modify it.
+func (k *PrivateKey) Scalar() types.Scalar {
+	return k.scalar
+}
+
+// Public returns the corresponding public key.
+func (k *PrivateKey) Public() *PublicKey {
+	return k.public
+}
+
+// Sign signs the encoded msg point.
+func (k *PrivateKey) Sign(msg types.Point) ([]byte, error) {
+	return k.curve.Sign(k.scalar, msg)
+}
+
+// DeriveChild derives the private key of child index, the parent scalar
+// plus H(parent public key || index) modulo the curve order, like BIP32's
+// non-hardened derivation. The child public key is Public().DeriveChild(index).
+//
+// Since the tweak only depends on public values, a child private key and the
+// parent public key together reveal the parent private key.
+func (k *PrivateKey) DeriveChild(index uint32) (*PrivateKey, error) {
+	tweak, err := childTweak(k.public, index)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPrivateKey(k.curve, k.scalar.Add(tweak))
+}
+
+// Zeroize wipes the key's scalar. The key must not be used afterwards.
+func (k *PrivateKey) Zeroize() {
+	k.scalar.Zeroize()
+}
@@ -0,0 +1,193 @@
+package keys
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+	"github.com/pokt-network/go-dleq/types"
+)
+
+func allCurves() []types.Curve {
+	return []types.Curve{
+		secp256k1.NewCurve(),
+		ed25519.NewCurve(),
+	}
+}
+
+func TestKeys(t *testing.T) {
+	for _, curve := range allCurves() {
+		sk, err := GeneratePrivateKey(curve)
+		require.NoError(t, err)
+
+		pk := sk.Public()
+		require.True(t, pk.Point().Equals(curve.ScalarBaseMul(sk.Scalar())))
+		require.Len(t, pk.Fingerprint(), FingerprintLength)
+
+		// round trips
+		parsedPk, err := ParsePublicKey(curve, pk.Encode())
+		require.NoError(t, err)
+		require.True(t, parsedPk.Equals(pk))
+		require.Equal(t, pk.Fingerprint(), parsedPk.Fingerprint())
+
+		parsedSk, err := ParsePrivateKey(curve, sk.Scalar().Encode())
+		require.NoError(t, err)
+		require.True(t, parsedSk.Public().Equals(pk))
+
+		msg := curve.ScalarBaseMul(curve.NewRandomScalar())
+		sig, err := sk.Sign(msg)
+		require.NoError(t, err)
+		require.True(t, pk.Verify(msg, sig))
+
+		other, err := GeneratePrivateKey(curve)
+		require.NoError(t, err)
+		require.False(t, other.Public().Verify(msg, sig))
+		require.False(t, bytes.Equal(pk.Fingerprint(), other.Public().Fingerprint()))
+	}
+}
+
+func TestKeys_InvalidPublicKey(t *testing.T) {
+	secp := secp256k1.NewCurve()
+	ed := ed25519.NewCurve()
+
+	for _, curve := range allCurves() {
+		_, err := NewPublicKey(curve, curve.Identity())
+		require.Error(t, err)
+	}
+
+	// a point from the other curve
+	_, err := NewPublicKey(secp, ed.BasePoint())
+	require.Error(t, err)
+	_, err = NewPublicKey(ed, secp.BasePoint())
+	require.Error(t, err)
+
+	// an x coordinate that isn't on secp256k1
+	notOnCurve, err := hex.DecodeString("02eefdea4cdb677750a420fee807eacf21eb9898ae79b9768766e4faa04a2d4a34")
+	require.NoError(t, err)
+	_, err = ParsePublicKey(secp, notOnCurve)
+	require.Error(t, err)
+
+	// an ed25519 point with a small-order component
+	torsion, err := hex.DecodeString("ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f")
+	require.NoError(t, err)
+	_, err = ParsePublicKey(ed, torsion)
+	require.Error(t, err)
+
+	_, err = ParsePublicKey(secp, []byte{0x02})
+	require.Error(t, err)
+}
+
+func TestKeys_InvalidPrivateKey(t *testing.T) {
+	secp := secp256k1.NewCurve()
+	ed := ed25519.NewCurve()
+
+	for _, curve := range allCurves() {
+		_, err := NewPrivateKey(curve, curve.ScalarFromInt(0))
+		require.Error(t, err)
+
+		_, err = ParsePrivateKey(curve, make([]byte, 32))
+		require.Error(t, err)
+
+		_, err = ParsePrivateKey(curve, make([]byte, 31))
+		require.Error(t, err)
+	}
+
+	// the group orders themselves, in each curve's byte order
+	secpOrder, err := hex.DecodeString("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141")
+	require.NoError(t, err)
+	_, err = ParsePrivateKey(secp, secpOrder)
+	require.Error(t, err)
+
+	edOrder, err := hex.DecodeString("edd3f55c1a631258d69cf7a2def9de1400000000000000000000000000000010")
+	require.NoError(t, err)
+	_, err = ParsePrivateKey(ed, edOrder)
+	require.Error(t, err)
+}
+
+func TestPrivateKey_DeriveChild(t *testing.T) {
+	for _, curve := range allCurves() {
+		sk, err := GeneratePrivateKey(curve)
+		require.NoError(t, err)
+
+		seen := make(map[string]bool)
+		for _, index := range []uint32{0, 1, 2, 1 << 31, ^uint32(0)} {
+			child, err := sk.DeriveChild(index)
+			require.NoError(t, err)
+
+			// the public side derives the same child from the parent public key
+			childPk, err := sk.Public().DeriveChild(index)
+			require.NoError(t, err)
+			require.True(t, curve.ScalarBaseMul(child.Scalar()).Equals(childPk.Point()))
+			require.True(t, child.Public().Equals(childPk))
+
+			// deterministic, and distinct per index
+			again, err := sk.DeriveChild(index)
+			require.NoError(t, err)
+			require.True(t, again.Scalar().Eq(child.Scalar()))
+
+			enc := string(childPk.Encode())
+			require.False(t, seen[enc])
+			seen[enc] = true
+		}
+	}
+}
+
+// TestPublicKey_DeriveChild derives a chain of public keys from an encoded
+// public key alone, as a watch-only wallet would, and checks it against the
+// private derivation.
+func TestPublicKey_DeriveChild(t *testing.T) {
+	for _, curve := range allCurves() {
+		sk, err := GeneratePrivateKey(curve)
+		require.NoError(t, err)
+
+		watchOnly, err := ParsePublicKey(curve, sk.Public().Encode())
+		require.NoError(t, err)
+
+		for _, index := range []uint32{7, 0, 1 << 31} {
+			sk, err = sk.DeriveChild(index)
+			require.NoError(t, err)
+			watchOnly, err = watchOnly.DeriveChild(index)
+			require.NoError(t, err)
+			require.True(t, curve.ScalarBaseMul(sk.Scalar()).Equals(watchOnly.Point()))
+		}
+
+		// the path matters, not just the index: child 3 along 7/3 and 8/3
+		// differ
+		pathA, err := watchOnly.DeriveChild(7)
+		require.NoError(t, err)
+		pathA, err = pathA.DeriveChild(3)
+		require.NoError(t, err)
+		pathB, err := watchOnly.DeriveChild(8)
+		require.NoError(t, err)
+		pathB, err = pathB.DeriveChild(3)
+		require.NoError(t, err)
+		require.False(t, pathA.Equals(pathB))
+	}
+}
+
+func TestDeriveChildPoint(t *testing.T) {
+	for _, curve := range allCurves() {
+		sk, err := GeneratePrivateKey(curve)
+		require.NoError(t, err)
+		parent := sk.Public().Point()
+
+		for _, index := range []uint32{0, 1, 1 << 31} {
+			child, err := DeriveChildPoint(curve, parent, parent.Encode(), index)
+			require.NoError(t, err)
+			childSk, err := sk.DeriveChild(index)
+			require.NoError(t, err)
+			require.True(t, child.Equals(curve.ScalarBaseMul(childSk.Scalar())))
+		}
+
+		// an encoding of another point, and an invalid parent
+		other := curve.ScalarBaseMul(curve.NewRandomScalar())
+		_, err = DeriveChildPoint(curve, parent, other.Encode(), 0)
+		require.Error(t, err)
+		_, err = DeriveChildPoint(curve, curve.Identity(), curve.Identity().Encode(), 0)
+		require.Error(t, err)
+	}
+}
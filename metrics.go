@@ -0,0 +1,82 @@
+package dleq
+
+import (
+	"time"
+)
+
+// VerifyMetrics reports where the time of a verification went.
+type VerifyMetrics struct {
+	// Commitments is the time spent checking that the bit commitments sum
+	// to the public keys and verifying the signatures by the public keys.
+	Commitments time.Duration
+
+	// Challenges is the time spent building and hashing the ring signature
+	// challenges.
+	Challenges time.Duration
+
+	// BitProofs is the time spent on the rest of the bit proofs, which is
+	// mostly their scalar multiplications.
+	BitProofs time.Duration
+
+	// Total is the time the whole verification took.
+	Total time.Duration
+
+	// ScalarMuls is the number of scalar multiplications done, not
+	// counting those within the signature verification.
+	ScalarMuls int
+}
+
+// VerifyWithMetrics is like Verify, also returning how long each phase of
+// the verification took. The metrics cover the phases run before any
+// failure. Verify and VerifyWith don't collect metrics.
+func (p *Proof) VerifyWithMetrics(curveA, curveB Curve) (VerifyMetrics, error) {
+	var metrics VerifyMetrics
+	start := time.Now()
+	err := p.VerifyWith(&VerifyContext{metrics: &metrics}, curveA, curveB)
+	metrics.Total = time.Since(start)
+
+	// the challenges are timed within the bit proofs
+	metrics.BitProofs -= metrics.Challenges
+	return metrics, err
+}
+
+type verifyPhase int
+
+const (
+	phaseCommitments verifyPhase = iota
+	phaseChallenges
+	phaseBitProofs
+)
+
+// now returns the current time, or the zero time if m is nil, ie. no
+// metrics are being collected.
+func (m *VerifyMetrics) now() time.Time {
+	if m == nil {
+		return time.Time{}
+	}
+
+	return time.Now()
+}
+
+// record adds the time since start to the phase, if m isn't nil.
+func (m *VerifyMetrics) record(phase verifyPhase, start time.Time) {
+	if m == nil {
+		return
+	}
+
+	d := time.Since(start)
+	switch phase {
+	case phaseCommitments:
+		m.Commitments += d
+	case phaseChallenges:
+		m.Challenges += d
+	case phaseBitProofs:
+		m.BitProofs += d
+	}
+}
+
+func (m *VerifyMetrics) countScalarMuls(n int) {
+	if m != nil {
+		m.ScalarMuls += n
+	}
+}
@@ -0,0 +1,40 @@
+package dleq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+func TestProof_VerifyWithMetrics(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	metrics, err := proof.VerifyWithMetrics(curveA, curveB)
+	require.NoError(t, err)
+
+	// 8 per bit proof, and all but one commitment per curve for the sums
+	bits := len(proof.proofs)
+	require.Equal(t, 8*bits+2*(bits-1), metrics.ScalarMuls)
+
+	require.Positive(t, metrics.Commitments)
+	require.Positive(t, metrics.Challenges)
+	require.Positive(t, metrics.BitProofs)
+	require.LessOrEqual(t, metrics.Commitments+metrics.Challenges+metrics.BitProofs, metrics.Total)
+
+	// a failure part way through reports the phases run so far
+	const corrupted = 10
+	ringSig := &proof.proofs[corrupted].ringSig
+	ringSig.b1 = ringSig.b1.Add(curveB.ScalarFromInt(1))
+
+	metrics, err = proof.VerifyWithMetrics(curveA, curveB)
+	require.ErrorContains(t, err, "bit 10")
+	require.Equal(t, 8*(corrupted+1)+2*(bits-1), metrics.ScalarMuls)
+}
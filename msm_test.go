@@ -0,0 +1,50 @@
+package dleq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/types"
+)
+
+func TestMultiScalarMul(t *testing.T) {
+	for _, curve := range allCurves() {
+		for _, n := range []int{0, 1, 2, 7, 33} {
+			scalars := make([]Scalar, n)
+			points := make([]Point, n)
+			for i := range scalars {
+				scalars[i] = curve.NewRandomScalar()
+				points[i] = curve.ScalarBaseMul(curve.NewRandomScalar())
+			}
+
+			// edge cases: a zero scalar, -1 and a repeated point
+			if n > 2 {
+				scalars[0] = curve.ScalarFromInt(0)
+				scalars[1] = curve.ScalarFromInt(1).Negate()
+				points[2] = points[1]
+			}
+
+			expected := curve.Identity()
+			for i := range scalars {
+				expected = expected.Add(points[i].ScalarMul(scalars[i]))
+			}
+
+			for _, w := range []int{0, 1, 2, 3, 4, 5, 8} {
+				res, err := types.MultiScalarMulWithConfig(curve, scalars, points, types.MultiScalarMulConfig{WindowBits: w})
+				require.NoError(t, err)
+				require.True(t, expected.Equals(res), "n=%d w=%d", n, w)
+			}
+
+			res, err := types.MultiScalarMul(curve, scalars, points)
+			require.NoError(t, err)
+			require.True(t, expected.Equals(res))
+		}
+
+		_, err := types.MultiScalarMul(curve, []Scalar{curve.ScalarFromInt(1)}, nil)
+		require.Error(t, err)
+
+		_, err = types.MultiScalarMulWithConfig(curve, nil, nil, types.MultiScalarMulConfig{WindowBits: 17})
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,121 @@
+package dleq
+
+import (
+	"crypto"
+	"encoding/binary"
+	"fmt"
+)
+
+const protocolTagDomain = "go-dleq/protocol-tag/v1"
+
+// ProofOptions configures NewProofWithOptions and VerifyWithOptions.
+type ProofOptions struct {
+	// ProtocolTag identifies the protocol, and its version, that the proof
+	// is made for, eg. "myprotocol/v2". It's mixed into every Fiat-Shamir
+	// challenge, so the proof only verifies with the same tag. The tag
+	// isn't stored in the proof; the verifier supplies it. An empty tag
+	// gives the same proofs as NewProof.
+	ProtocolTag []byte
+
+	// Hash is the hash of the Fiat-Shamir challenges. The digest is read
+	// as an integer in the curve's scalar byte order and reduced modulo the
+	// group order. Zero selects the default, SHA3-512, which is also what
+	// NewProof uses. Only SHA-256, SHA-384, SHA-512 and SHA3-256, SHA3-384
+	// and SHA3-512 are accepted; weaker hashes such as MD5 and SHA-1 are
+	// rejected. The hash must be linked into the binary, eg. by importing
+	// crypto/sha256 for crypto.SHA256.
+	Hash crypto.Hash
+}
+
+// NewProofWithOptions is like NewProof, with the given options.
+func NewProofWithOptions(curveA, curveB Curve, x [32]byte, opts ProofOptions) (*Proof, error) {
+	err := opts.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	proof, _, err := newProof(opts.curve(curveA), opts.curve(curveB), x, systemRandom, nil)
+	return proof, err
+}
+
+// VerifyWithOptions verifies a proof made by NewProofWithOptions with the
+// same options.
+func (p *Proof) VerifyWithOptions(curveA, curveB Curve, opts ProofOptions) error {
+	err := opts.validate()
+	if err != nil {
+		return err
+	}
+
+	return p.Verify(opts.curve(curveA), opts.curve(curveB))
+}
+
+func (opts ProofOptions) validate() error {
+	if opts.Hash == 0 {
+		return nil
+	}
+
+	switch opts.Hash {
+	case crypto.SHA256, crypto.SHA384, crypto.SHA512, crypto.SHA3_256, crypto.SHA3_384, crypto.SHA3_512:
+	default:
+		return fmt.Errorf("challenge hash %v is not supported", opts.Hash)
+	}
+
+	if !opts.Hash.Available() {
+		return fmt.Errorf("challenge hash %v is not available", opts.Hash)
+	}
+
+	return nil
+}
+
+// curve returns the curve with the options applied.
+func (opts ProofOptions) curve(curve Curve) Curve {
+	if len(opts.ProtocolTag) == 0 && opts.Hash == 0 {
+		return curve
+	}
+
+	var prefix []byte
+	if len(opts.ProtocolTag) != 0 {
+		for _, b := range [][]byte{[]byte(protocolTagDomain), opts.ProtocolTag} {
+			prefix = binary.BigEndian.AppendUint64(prefix, uint64(len(b)))
+			prefix = append(prefix, b...)
+		}
+	}
+
+	return &optionsCurve{
+		Curve:  curve,
+		prefix: prefix,
+		hash:   opts.Hash,
+	}
+}
+
+// optionsCurve computes the challenges as configured by ProofOptions: it
+// prefixes every preimage with the protocol tag, if any, and hashes it with
+// the configured hash.
+type optionsCurve struct {
+	Curve
+	prefix []byte
+	hash   crypto.Hash
+}
+
+func (c *optionsCurve) HashToScalar(in []byte) (Scalar, error) {
+	preimage := make([]byte, 0, len(c.prefix)+len(in))
+	preimage = append(preimage, c.prefix...)
+	preimage = append(preimage, in...)
+	if c.hash == 0 {
+		return c.Curve.HashToScalar(preimage)
+	}
+
+	h := c.hash.New()
+	h.Write(preimage)
+	digest := h.Sum(nil)
+
+	// the digest is the least significant end of the wide integer
+	var wide [64]byte
+	if c.Curve.ScalarFromInt(1).Encode()[0] == 1 {
+		copy(wide[:], digest)
+	} else {
+		copy(wide[64-len(digest):], digest)
+	}
+
+	return c.Curve.ReduceWide(wide), nil
+}
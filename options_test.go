@@ -0,0 +1,81 @@
+package dleq
+
+import (
+	"crypto"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	_ "golang.org/x/crypto/sha3"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+func TestNewProofWithOptions_ProtocolTag(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+
+	v2 := ProofOptions{ProtocolTag: []byte("v2")}
+	proof, err := NewProofWithOptions(curveA, curveB, x, v2)
+	require.NoError(t, err)
+	require.NoError(t, proof.VerifyWithOptions(curveA, curveB, v2))
+
+	// the tag is re-supplied rather than stored, so it survives a round trip
+	var decoded Proof
+	require.NoError(t, decoded.Deserialize(curveA, curveB, proof.Serialize()))
+	require.NoError(t, decoded.VerifyWithOptions(curveA, curveB, v2))
+
+	for _, opts := range []ProofOptions{
+		{ProtocolTag: []byte("v3")},
+		{ProtocolTag: []byte("v2\x00")},
+		{},
+	} {
+		require.Error(t, proof.VerifyWithOptions(curveA, curveB, opts), "tag %q", opts.ProtocolTag)
+	}
+	require.Error(t, proof.Verify(curveA, curveB))
+
+	// an untagged proof only verifies without a tag
+	untagged, err := NewProofWithOptions(curveA, curveB, x, ProofOptions{})
+	require.NoError(t, err)
+	require.NoError(t, untagged.Verify(curveA, curveB))
+	require.Error(t, untagged.VerifyWithOptions(curveA, curveB, v2))
+}
+
+func TestNewProofWithOptions_Hash(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+
+	sha256Opts := ProofOptions{Hash: crypto.SHA256}
+	proof, err := NewProofWithOptions(curveA, curveB, x, sha256Opts)
+	require.NoError(t, err)
+	require.NoError(t, proof.VerifyWithOptions(curveA, curveB, sha256Opts))
+
+	for _, opts := range []ProofOptions{
+		{},
+		{Hash: crypto.SHA3_512},
+		{Hash: crypto.SHA512},
+		{Hash: crypto.SHA256, ProtocolTag: []byte("v2")},
+	} {
+		require.Error(t, proof.VerifyWithOptions(curveA, curveB, opts), "hash %v", opts.Hash)
+	}
+	require.Error(t, proof.Verify(curveA, curveB))
+
+	// SHA3-512 is the default
+	proof, err = NewProofWithOptions(curveA, curveB, x, ProofOptions{Hash: crypto.SHA3_512})
+	require.NoError(t, err)
+	require.NoError(t, proof.Verify(curveA, curveB))
+	require.Error(t, proof.VerifyWithOptions(curveA, curveB, sha256Opts))
+
+	// weak or unknown hashes are rejected, whether or not they're linked in
+	for _, hash := range []crypto.Hash{crypto.MD4, crypto.MD5, crypto.SHA1, crypto.SHA224, crypto.SHA512_224, crypto.Hash(99)} {
+		_, err = NewProofWithOptions(curveA, curveB, x, ProofOptions{Hash: hash})
+		require.Error(t, err, "hash %v", hash)
+		require.Error(t, proof.VerifyWithOptions(curveA, curveB, ProofOptions{Hash: hash}), "hash %v", hash)
+	}
+}
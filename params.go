@@ -0,0 +1,44 @@
+package dleq
+
+import "math"
+
+// ProofParams describes the parameterization of a proof and the soundness
+// it gives.
+type ProofParams struct {
+	// Bits is the number of bits of the witness the proof covers, the bit
+	// size of the smaller curve.
+	Bits int
+
+	// ChallengeBits is log2 of the size of the challenge space of each
+	// bit's ring signature. The challenges are scalars on each curve, so
+	// it's the log2 of the smaller group order, rounded down.
+	ChallengeBits int
+
+	// SoundnessError bounds the probability that a proof of a false
+	// statement verifies, for a prover that makes a single attempt: each
+	// of the Bits ring signatures can be forged by guessing its challenge,
+	// with probability 2^-ChallengeBits.
+	SoundnessError float64
+}
+
+// Parameters returns the parameters of a proof made by NewProof or decoded
+// by Deserialize. It's informational; it doesn't check the proof is valid.
+func (p *Proof) Parameters() ProofParams {
+	bits := len(p.proofs)
+	return ProofParams{
+		Bits:           bits,
+		ChallengeBits:  p.challengeBits,
+		SoundnessError: math.Ldexp(float64(bits), -p.challengeBits),
+	}
+}
+
+// challengeBits returns log2 of the smaller group order of the curves,
+// rounded down.
+func challengeBits(curveA, curveB Curve) int {
+	bits := curveA.Order().BitLen()
+	if bitsB := curveB.Order().BitLen(); bitsB < bits {
+		bits = bitsB
+	}
+
+	return bits - 1
+}
@@ -0,0 +1,34 @@
+package dleq
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+func TestProof_Parameters(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	params := proof.Parameters()
+	require.Equal(t, int(min(curveA.BitSize(), curveB.BitSize())), params.Bits)
+	require.Equal(t, 252, params.Bits)
+
+	// the ed25519 order is just above 2^252
+	require.Equal(t, 252, params.ChallengeBits)
+	require.Equal(t, math.Ldexp(252, -252), params.SoundnessError)
+
+	var decoded Proof
+	err = decoded.Deserialize(curveA, curveB, proof.Serialize())
+	require.NoError(t, err)
+	require.Equal(t, params, decoded.Parameters())
+}
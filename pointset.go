@@ -0,0 +1,78 @@
+package dleq
+
+import (
+	"errors"
+	"sync"
+)
+
+// ValidatedPointSet remembers the encodings of points that have passed
+// validation, so that validating the same public keys again, eg. on every
+// message, doesn't decode and check them each time. Invalid encodings are
+// never remembered. It's safe for concurrent use.
+type ValidatedPointSet struct {
+	curve   Curve
+	maxSize int
+
+	mu    sync.RWMutex
+	valid map[string]struct{}
+}
+
+// NewValidatedPointSet returns a set for points of the given curve holding
+// at most maxSize encodings. Once it's full, an arbitrary encoding is
+// forgotten for each new one.
+func NewValidatedPointSet(curve Curve, maxSize int) (*ValidatedPointSet, error) {
+	if maxSize < 1 {
+		return nil, errors.New("validated point set size must be positive")
+	}
+
+	return &ValidatedPointSet{
+		curve:   curve,
+		maxSize: maxSize,
+		valid:   make(map[string]struct{}, maxSize),
+	}, nil
+}
+
+// ValidatePointBytes decodes the compressed point and validates it as the
+// curve's ValidatePoint does, unless the same encoding has already passed.
+func (s *ValidatedPointSet) ValidatePointBytes(enc []byte) error {
+	s.mu.RLock()
+	_, ok := s.valid[string(enc)]
+	s.mu.RUnlock()
+	if ok {
+		return nil
+	}
+
+	p, err := s.curve.DecodeToPoint(enc)
+	if err != nil {
+		return err
+	}
+
+	err = s.curve.ValidatePoint(p)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.valid[string(enc)]; ok {
+		return nil
+	}
+
+	if len(s.valid) >= s.maxSize {
+		for k := range s.valid {
+			delete(s.valid, k)
+			break
+		}
+	}
+
+	s.valid[string(enc)] = struct{}{}
+	return nil
+}
+
+// Len returns the number of encodings in the set.
+func (s *ValidatedPointSet) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.valid)
+}
@@ -0,0 +1,82 @@
+package dleq
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// decodeCountingCurve counts calls to DecodeToPoint.
+type decodeCountingCurve struct {
+	Curve
+	decodes atomic.Int64
+}
+
+func (c *decodeCountingCurve) DecodeToPoint(in []byte) (Point, error) {
+	c.decodes.Add(1)
+	return c.Curve.DecodeToPoint(in)
+}
+
+func TestValidatedPointSet(t *testing.T) {
+	for _, curve := range allCurves() {
+		counting := &decodeCountingCurve{Curve: curve}
+		set, err := NewValidatedPointSet(counting, 4)
+		require.NoError(t, err)
+
+		// a miss decodes, a hit doesn't
+		enc := curve.ScalarBaseMul(curve.NewRandomScalar()).Encode()
+		require.NoError(t, set.ValidatePointBytes(enc))
+		require.NoError(t, set.ValidatePointBytes(enc))
+		require.Equal(t, int64(1), counting.decodes.Load())
+		require.Equal(t, 1, set.Len())
+
+		// invalid points are rejected every time and never remembered
+		for _, invalid := range [][]byte{
+			curve.Identity().Encode(),
+			make([]byte, curve.CompressedPointSize()-1),
+			nil,
+		} {
+			for i := 0; i < 2; i++ {
+				require.Error(t, set.ValidatePointBytes(invalid), "%s %x", curve.Name(), invalid)
+			}
+		}
+		require.Equal(t, int64(7), counting.decodes.Load())
+		require.Equal(t, 1, set.Len())
+
+		// the set stays within its size
+		for i := 0; i < 8; i++ {
+			require.NoError(t, set.ValidatePointBytes(curve.ScalarBaseMul(curve.NewRandomScalar()).Encode()))
+		}
+		require.Equal(t, 4, set.Len())
+
+		_, err = NewValidatedPointSet(curve, 0)
+		require.Error(t, err)
+	}
+}
+
+func TestValidatedPointSet_Concurrent(t *testing.T) {
+	for _, curve := range allCurves() {
+		set, err := NewValidatedPointSet(curve, 8)
+		require.NoError(t, err)
+
+		encs := make([][]byte, 16)
+		for i := range encs {
+			encs[i] = curve.ScalarBaseMul(curve.NewRandomScalar()).Encode()
+		}
+
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 64; i++ {
+					require.NoError(t, set.ValidatePointBytes(encs[(g+i)%len(encs)]))
+				}
+			}()
+		}
+		wg.Wait()
+		require.LessOrEqual(t, set.Len(), 8)
+	}
+}
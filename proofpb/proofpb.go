@@ -0,0 +1,242 @@
+// Package proofpb holds the protobuf messages of a proof, as described by
+// proof.proto, for carrying proofs in protobuf and gRPC messages. The
+// messages are written by hand rather than generated, so that the module
+// doesn't depend on the protobuf runtime, but have the wire format of
+// proof.proto and interoperate with code generated from it.
+//
+// Convert to and from a proof with dleq.Proof's ToProto and FromProto.
+package proofpb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Protobuf wire types.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// Proof is the Proof message.
+type Proof struct {
+	CurveIdA    uint32
+	CurveIdB    uint32
+	CommitmentA []byte
+	CommitmentB []byte
+	BitProofs   []*BitProof
+	SignatureA  []byte
+	SignatureB  []byte
+}
+
+// BitProof is the BitProof message.
+type BitProof struct {
+	CommitmentA []byte
+	CommitmentB []byte
+	ECurveA     []byte
+	ECurveB     []byte
+	A0          []byte
+	A1          []byte
+	B0          []byte
+	B1          []byte
+}
+
+// Marshal returns the protobuf encoding of the message.
+func (m *Proof) Marshal() ([]byte, error) {
+	var b []byte
+	b = appendVarint(b, 1, uint64(m.CurveIdA))
+	b = appendVarint(b, 2, uint64(m.CurveIdB))
+	b = appendBytes(b, 3, m.CommitmentA)
+	b = appendBytes(b, 4, m.CommitmentB)
+	for _, bp := range m.BitProofs {
+		enc, err := bp.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		// unlike a bytes field, an empty message is still an element
+		b = appendTag(b, 5, wireBytes)
+		b = binary.AppendUvarint(b, uint64(len(enc)))
+		b = append(b, enc...)
+	}
+
+	b = appendBytes(b, 6, m.SignatureA)
+	b = appendBytes(b, 7, m.SignatureB)
+	return b, nil
+}
+
+// Unmarshal decodes the protobuf encoding of the message, replacing its
+// contents. Unknown fields are skipped.
+func (m *Proof) Unmarshal(in []byte) error {
+	*m = Proof{}
+	return parseFields(in, func(field, wireType, v uint64, data []byte) error {
+		switch field {
+		case 1:
+			return setUint32(&m.CurveIdA, field, wireType, v)
+		case 2:
+			return setUint32(&m.CurveIdB, field, wireType, v)
+		case 3:
+			return setBytes(&m.CommitmentA, field, wireType, data)
+		case 4:
+			return setBytes(&m.CommitmentB, field, wireType, data)
+		case 5:
+			if wireType != wireBytes {
+				return wireTypeError(field, wireType)
+			}
+
+			bp := new(BitProof)
+			err := bp.Unmarshal(data)
+			if err != nil {
+				return fmt.Errorf("invalid bit proof %d: %w", len(m.BitProofs), err)
+			}
+
+			m.BitProofs = append(m.BitProofs, bp)
+		case 6:
+			return setBytes(&m.SignatureA, field, wireType, data)
+		case 7:
+			return setBytes(&m.SignatureB, field, wireType, data)
+		}
+
+		return nil
+	})
+}
+
+// Marshal returns the protobuf encoding of the message.
+func (m *BitProof) Marshal() ([]byte, error) {
+	var b []byte
+	for i, f := range m.fields() {
+		b = appendBytes(b, uint64(i+1), *f)
+	}
+
+	return b, nil
+}
+
+// Unmarshal decodes the protobuf encoding of the message, replacing its
+// contents. Unknown fields are skipped.
+func (m *BitProof) Unmarshal(in []byte) error {
+	*m = BitProof{}
+	fields := m.fields()
+	return parseFields(in, func(field, wireType, _ uint64, data []byte) error {
+		// unknown fields are skipped
+		if field > uint64(len(fields)) {
+			return nil
+		}
+
+		return setBytes(fields[field-1], field, wireType, data)
+	})
+}
+
+// fields returns the message's fields, in field number order.
+func (m *BitProof) fields() []*[]byte {
+	return []*[]byte{&m.CommitmentA, &m.CommitmentB, &m.ECurveA, &m.ECurveB, &m.A0, &m.A1, &m.B0, &m.B1}
+}
+
+func appendTag(b []byte, field, wireType uint64) []byte {
+	return binary.AppendUvarint(b, field<<3|wireType)
+}
+
+// appendVarint appends a varint field, omitting it if it's zero as proto3
+// does.
+func appendVarint(b []byte, field, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+
+	return binary.AppendUvarint(appendTag(b, field, wireVarint), v)
+}
+
+// appendBytes appends a bytes field, omitting it if it's empty as proto3
+// does.
+func appendBytes(b []byte, field uint64, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+
+	b = binary.AppendUvarint(appendTag(b, field, wireBytes), uint64(len(v)))
+	return append(b, v...)
+}
+
+// setUint32 sets a uint32 field, truncating v as protobuf does.
+func setUint32(dst *uint32, field, wireType, v uint64) error {
+	if wireType != wireVarint {
+		return wireTypeError(field, wireType)
+	}
+
+	*dst = uint32(v)
+	return nil
+}
+
+// setBytes sets a bytes field to a copy of data.
+func setBytes(dst *[]byte, field, wireType uint64, data []byte) error {
+	if wireType != wireBytes {
+		return wireTypeError(field, wireType)
+	}
+
+	*dst = bytes.Clone(data)
+	return nil
+}
+
+func wireTypeError(field, wireType uint64) error {
+	return fmt.Errorf("field %d has wrong wire type %d", field, wireType)
+}
+
+// parseFields calls fn with each field of an encoded message: its value v
+// for a varint, or its contents for a length-delimited field.
+func parseFields(in []byte, fn func(field, wireType, v uint64, data []byte) error) error {
+	for len(in) > 0 {
+		key, n := binary.Uvarint(in)
+		if n <= 0 {
+			return errors.New("invalid field key")
+		}
+
+		in = in[n:]
+		field, wireType := key>>3, key&7
+		if field == 0 {
+			return errors.New("invalid field number 0")
+		}
+
+		var v uint64
+		var data []byte
+		switch wireType {
+		case wireVarint:
+			v, n = binary.Uvarint(in)
+			if n <= 0 {
+				return fmt.Errorf("invalid varint in field %d", field)
+			}
+
+			in = in[n:]
+		case wireFixed64, wireFixed32:
+			size := 8
+			if wireType == wireFixed32 {
+				size = 4
+			}
+
+			if len(in) < size {
+				return fmt.Errorf("truncated field %d", field)
+			}
+
+			in = in[size:]
+		case wireBytes:
+			l, n := binary.Uvarint(in)
+			if n <= 0 || l > uint64(len(in)-n) {
+				return fmt.Errorf("truncated field %d", field)
+			}
+
+			data = in[n : n+int(l)]
+			in = in[n+int(l):]
+		default:
+			return fmt.Errorf("unsupported wire type %d in field %d", wireType, field)
+		}
+
+		err := fn(field, wireType, v, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
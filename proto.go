@@ -0,0 +1,133 @@
+package dleq
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/pokt-network/go-dleq/proofpb"
+)
+
+// ToProto returns the proof as a protobuf message. Like MarshalBinary, it
+// records the curves, which must be registered.
+func (p *Proof) ToProto() (*proofpb.Proof, error) {
+	if p.curveIDA == 0 || p.curveIDB == 0 {
+		return nil, fmt.Errorf("proof curves are not registered")
+	}
+
+	m := &proofpb.Proof{
+		CurveIdA:    uint32(p.curveIDA),
+		CurveIdB:    uint32(p.curveIDB),
+		CommitmentA: p.CommitmentA.Encode(),
+		CommitmentB: p.CommitmentB.Encode(),
+		BitProofs:   make([]*proofpb.BitProof, len(p.proofs)),
+		SignatureA:  bytes.Clone(p.signatureA.inner),
+		SignatureB:  bytes.Clone(p.signatureB.inner),
+	}
+
+	for i, bp := range p.proofs {
+		m.BitProofs[i] = &proofpb.BitProof{
+			CommitmentA: bp.commitmentA.commitment.Encode(),
+			CommitmentB: bp.commitmentB.commitment.Encode(),
+			ECurveA:     bp.ringSig.eCurveA.Encode(),
+			ECurveB:     bp.ringSig.eCurveB.Encode(),
+			A0:          bp.ringSig.a0.Encode(),
+			A1:          bp.ringSig.a1.Encode(),
+			B0:          bp.ringSig.b0.Encode(),
+			B1:          bp.ringSig.b1.Encode(),
+		}
+	}
+
+	return m, nil
+}
+
+// FromProto decodes a proof from a protobuf message made by ToProto, for
+// the curves registered with the message's identifiers. It doesn't verify
+// the proof.
+func (p *Proof) FromProto(m *proofpb.Proof) error {
+	if m.CurveIdA > math.MaxUint8 || m.CurveIdB > math.MaxUint8 {
+		return fmt.Errorf("invalid curve identifiers %d and %d", m.CurveIdA, m.CurveIdB)
+	}
+
+	curveA, err := CurveByID(uint8(m.CurveIdA))
+	if err != nil {
+		return err
+	}
+
+	curveB, err := CurveByID(uint8(m.CurveIdB))
+	if err != nil {
+		return err
+	}
+
+	if len(m.BitProofs) > math.MaxUint8 {
+		return fmt.Errorf("too many bit proofs: %d", len(m.BitProofs))
+	}
+
+	if len(m.SignatureA) > math.MaxUint8 || len(m.SignatureB) > math.MaxUint8 {
+		return fmt.Errorf("signature too long")
+	}
+
+	// the fields are laid out as Serialize does, once they're known to be
+	// the right length, so that they're decoded and validated as any other
+	// serialized proof
+	pointLenA := curveA.CompressedPointSize()
+	pointLenB := curveB.CompressedPointSize()
+	scalarLenA := curveA.ScalarSize()
+	scalarLenB := curveB.ScalarSize()
+
+	var ser []byte
+	add := func(fields ...protoField) error {
+		for _, f := range fields {
+			if len(f.value) != f.size {
+				return fmt.Errorf("%s is %d bytes, expected %d", f.name, len(f.value), f.size)
+			}
+
+			ser = append(ser, f.value...)
+		}
+
+		return nil
+	}
+
+	err = add(
+		protoField{"commitment A", m.CommitmentA, pointLenA},
+		protoField{"commitment B", m.CommitmentB, pointLenB},
+	)
+	if err != nil {
+		return err
+	}
+
+	ser = append(ser, byte(len(m.BitProofs)))
+	for i, bp := range m.BitProofs {
+		if bp == nil {
+			return fmt.Errorf("bit proof %d is missing", i)
+		}
+
+		err = add(
+			protoField{"commitment A", bp.CommitmentA, pointLenA},
+			protoField{"commitment B", bp.CommitmentB, pointLenB},
+			protoField{"challenge A", bp.ECurveA, scalarLenA},
+			protoField{"challenge B", bp.ECurveB, scalarLenB},
+			protoField{"a0", bp.A0, scalarLenA},
+			protoField{"a1", bp.A1, scalarLenA},
+			protoField{"b0", bp.B0, scalarLenB},
+			protoField{"b1", bp.B1, scalarLenB},
+		)
+		if err != nil {
+			return fmt.Errorf("invalid bit proof %d: %w", i, err)
+		}
+	}
+
+	for _, sig := range [][]byte{m.SignatureA, m.SignatureB} {
+		ser = append(ser, byte(len(sig)))
+		ser = append(ser, sig...)
+	}
+
+	return p.Deserialize(curveA, curveB, ser)
+}
+
+// protoField is a bytes field of a proof message and its expected size.
+type protoField struct {
+	name  string
+	value []byte
+	size  int
+}
@@ -0,0 +1,89 @@
+package dleq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/proofpb"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+func TestProof_ToProtoFromProto(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	m, err := proof.ToProto()
+	require.NoError(t, err)
+	require.Equal(t, uint32(CurveIDSecp256k1), m.CurveIdA)
+	require.Equal(t, uint32(CurveIDEd25519), m.CurveIdB)
+	require.Len(t, m.BitProofs, len(proof.proofs))
+
+	enc, err := m.Marshal()
+	require.NoError(t, err)
+
+	// curve_id_a = 1 and curve_id_b = 2 come first
+	require.Equal(t, []byte{0x08, 1, 0x10, 2}, enc[:4])
+
+	var decoded proofpb.Proof
+	require.NoError(t, decoded.Unmarshal(enc))
+	require.Equal(t, m, &decoded)
+
+	var res Proof
+	require.NoError(t, res.FromProto(&decoded))
+	require.NoError(t, res.Verify(curveA, curveB))
+	require.NoError(t, res.VerifyExpecting(curveA, curveB))
+	require.Equal(t, proof.Serialize(), res.Serialize())
+	idA, idB := res.CurveIDs()
+	require.Equal(t, CurveIDSecp256k1, idA)
+	require.Equal(t, CurveIDEd25519, idB)
+
+	// fields added by a later version are skipped
+	withUnknown := append(append([]byte{}, enc...), 0x78, 1, 0x82, 0x01, 2, 0xaa, 0xbb)
+	require.NoError(t, decoded.Unmarshal(withUnknown))
+	require.Equal(t, m, &decoded)
+}
+
+func TestProof_FromProto_Invalid(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	for name, corrupt := range map[string]func(m *proofpb.Proof){
+		"unregistered curve": func(m *proofpb.Proof) { m.CurveIdB = 200 },
+		"curve id too large": func(m *proofpb.Proof) { m.CurveIdA = 257 },
+		"short commitment":   func(m *proofpb.Proof) { m.CommitmentA = m.CommitmentA[1:] },
+		"long scalar":        func(m *proofpb.Proof) { m.BitProofs[7].B1 = append(m.BitProofs[7].B1, 0) },
+		"missing bit proof":  func(m *proofpb.Proof) { m.BitProofs[3] = nil },
+		"invalid point":      func(m *proofpb.Proof) { m.BitProofs[0].CommitmentA[0] = 0x05 },
+	} {
+		m, err := proof.ToProto()
+		require.NoError(t, err)
+		corrupt(m)
+
+		var res Proof
+		require.Error(t, res.FromProto(m), name)
+	}
+
+	// a decoded proof with a missing bit doesn't verify
+	m, err := proof.ToProto()
+	require.NoError(t, err)
+	m.BitProofs = m.BitProofs[1:]
+	var res Proof
+	require.NoError(t, res.FromProto(m))
+	require.Error(t, res.Verify(curveA, curveB))
+
+	// truncated encodings are rejected
+	enc, err := m.Marshal()
+	require.NoError(t, err)
+	var decoded proofpb.Proof
+	require.Error(t, decoded.Unmarshal(enc[:len(enc)-1]))
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/pokt-network/go-dleq/types"
 )
@@ -17,6 +18,14 @@ type Proof struct {
 	CommitmentA, CommitmentB Point
 	proofs                   []bitProof
 	signatureA, signatureB   signature
+
+	// challengeBits is the size of the challenge space of the curves the
+	// proof was made or decoded for, see Parameters.
+	challengeBits int
+
+	// curveIDA and curveIDB identify the curves the proof was made or
+	// decoded for, see CurveIDs.
+	curveIDA, curveIDB uint8
 }
 
 type signature struct {
@@ -43,76 +52,195 @@ type ringSignature struct {
 }
 
 // GenerateSecretForCurves generates a secret value that has a corresponding
-// commitment on both curves.
+// commitment on both curves. Trivially weak secrets, ie. 0, 1 and -1 modulo
+// either curve's order, are never returned.
 func GenerateSecretForCurves(curveA, curveB Curve) ([32]byte, error) {
+	return generateSecretForCurves(curveA, curveB, rand.Reader)
+}
+
+func generateSecretForCurves(curveA, curveB Curve, r io.Reader) ([32]byte, error) {
 	bits := min(curveA.BitSize(), curveB.BitSize())
-	return generateRandomBits(bits)
+	for {
+		x, err := readRandomBits(r, bits)
+		if err != nil {
+			return x, err
+		}
+
+		if !isDegenerateSecret(curveA, x) && !isDegenerateSecret(curveB, x) {
+			return x, nil
+		}
+
+		clear(x[:])
+	}
+}
+
+// isDegenerateSecret reports whether x is 0, 1 or -1 on the given curve.
+func isDegenerateSecret(curve Curve, x [32]byte) bool {
+	s := curve.ScalarFromBytes(x)
+	defer s.Zeroize()
+
+	one := curve.ScalarFromInt(1)
+	return s.IsZero() || s.Eq(one) || s.Eq(one.Negate())
+}
+
+// ValidateSecretForCurves is a cheap check of an imported secret before
+// NewProof: it must fit in the bits proven for the curves, and pass each
+// curve's ValidateSecretStrength.
+func ValidateSecretForCurves(curveA, curveB Curve, x [32]byte) error {
+	defer clear(x[:])
+
+	err := checkWitnessSize(x, min(curveA.BitSize(), curveB.BitSize()))
+	if err != nil {
+		return err
+	}
+
+	err = curveA.ValidateSecretStrength(x)
+	if err != nil {
+		return fmt.Errorf("%s: %w", curveA.Name(), err)
+	}
+
+	err = curveB.ValidateSecretStrength(x)
+	if err != nil {
+		return fmt.Errorf("%s: %w", curveB.Name(), err)
+	}
+
+	return nil
+}
+
+// scalarSource returns the random scalars used while constructing a proof.
+type scalarSource func(curve Curve) (Scalar, error)
+
+// systemRandom draws scalars from the curve's random number generator.
+func systemRandom(curve Curve) (Scalar, error) {
+	return curve.NewRandomScalar(), nil
 }
 
 // NewProof returns a new proof for the given secret on the given curves.
-// The witness x must be in little-endian and smaller than the minimum order
-// of the two curves.
+// The witness x must be in little-endian, nonzero and smaller than the
+// minimum order of the two curves. This matches ScalarFromBytes and the
+// secrets returned by GenerateSecretForCurves; use NewProofBE for a
+// big-endian secret.
 func NewProof(curveA, curveB Curve, x [32]byte) (*Proof, error) {
+	proof, _, err := newProof(curveA, curveB, x, systemRandom, nil)
+	return proof, err
+}
+
+// NewProofLE is NewProof, with the little-endian interpretation of x made
+// explicit at the call site.
+func NewProofLE(curveA, curveB Curve, x [32]byte) (*Proof, error) {
+	return NewProof(curveA, curveB, x)
+}
+
+// NewProofBE returns a new proof for the given big-endian secret, eg. one
+// encoded like the secp256k1 DecodeToScalar input. It must be smaller than
+// the minimum order of the two curves.
+func NewProofBE(curveA, curveB Curve, x [32]byte) (*Proof, error) {
+	for i, j := 0, len(x)-1; i < j; i, j = i+1, j-1 {
+		x[i], x[j] = x[j], x[i]
+	}
+
+	return NewProof(curveA, curveB, x)
+}
+
+// NewProofWithBits is like NewProof, but also returns the bits of x that
+// were committed to, least significant first and one per byte. It's meant
+// for debugging on the prover side, eg. to check that x was passed in the
+// expected byte order. The bits are as secret as x itself.
+func NewProofWithBits(curveA, curveB Curve, x [32]byte) (*Proof, []byte, error) {
+	return newProof(curveA, curveB, x, systemRandom, nil)
+}
+
+// NewProofWithProgress is like NewProof, but calls onProgress after the
+// proof for each bit of x is generated, with the number of bits done so far
+// and the total, eg. to show a progress bar. It's called from the calling
+// goroutine, so it doesn't need to be safe for concurrent use.
+func NewProofWithProgress(curveA, curveB Curve, x [32]byte, onProgress func(done, total int)) (*Proof, error) {
+	proof, _, err := newProof(curveA, curveB, x, systemRandom, onProgress)
+	return proof, err
+}
+
+func newProof(curveA, curveB Curve, x [32]byte, random scalarSource, onProgress func(done, total int)) (*Proof, []byte, error) {
 	bits := min(curveA.BitSize(), curveB.BitSize())
 
 	err := checkWitnessSize(x, bits)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	// the public keys of zero are the identity, which can't sign
+	if x == [32]byte{} {
+		return nil, nil, errors.New("secret must not be zero")
+	}
+
+	// x is a copy of the caller's secret, wipe it along with the scalars
+	// derived from it
+	defer clear(x[:])
+
 	xA := curveA.ScalarFromBytes(x)
 	xB := curveB.ScalarFromBytes(x)
+	defer xA.Zeroize()
+	defer xB.Zeroize()
 	XA := curveA.ScalarBaseMul(xA)
 	XB := curveB.ScalarBaseMul(xB)
 
 	// generate commitments for each curve
-	commitmentsA, err := generateCommitments(curveA, x[:], bits)
+	commitmentsA, err := generateCommitments(curveA, x[:], bits, random)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	defer zeroizeBlinders(commitmentsA)
 
 	err = verifyCommitmentsSum(curveA, commitmentsA, XA)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	commitmentsB, err := generateCommitments(curveB, x[:], bits)
+	commitmentsB, err := generateCommitments(curveB, x[:], bits, random)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	defer zeroizeBlinders(commitmentsB)
 
 	err = verifyCommitmentsSum(curveB, commitmentsB, XB)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	proofs := make([]bitProof, bits)
+	committedBits := make([]byte, bits)
 
 	for i := 0; i < int(bits); i++ {
 		bit := getBit(x[:], uint64(i))
-		ringSig, err := generateRingSignature(curveA, curveB, bit, commitmentsA[i], commitmentsB[i])
+		committedBits[i] = bit
+		ringSig, err := generateRingSignature(curveA, curveB, bit, commitmentsA[i], commitmentsB[i], random)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
+		// the blinders are wiped when we return, so they're left out of
+		// the proof
 		proofs[i] = bitProof{
-			commitmentA: commitmentsA[i],
-			commitmentB: commitmentsB[i],
+			commitmentA: commitment{commitment: commitmentsA[i].commitment},
+			commitmentB: commitment{commitment: commitmentsB[i].commitment},
 			ringSig:     *ringSig,
 		}
+
+		if onProgress != nil {
+			onProgress(i+1, int(bits))
+		}
 	}
 
 	sigA, err := curveA.Sign(xA, XA)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	sigB, err := curveB.Sign(xB, XB)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &Proof{
+	proof := &Proof{
 		CommitmentA: XA,
 		CommitmentB: XB,
 		proofs:      proofs,
@@ -122,7 +250,18 @@ func NewProof(curveA, curveB Curve, x [32]byte) (*Proof, error) {
 		signatureB: signature{
 			sigB,
 		},
-	}, nil
+	}
+	proof.setCurves(curveA, curveB)
+	return proof, committedBits, nil
+}
+
+// zeroizeBlinders wipes the secret blinders of the given commitments.
+func zeroizeBlinders(commitments []commitment) {
+	for _, c := range commitments {
+		if c.blinder != nil {
+			c.blinder.Zeroize()
+		}
+	}
 }
 
 func checkWitnessSize(x [32]byte, bits uint64) error {
@@ -169,7 +308,7 @@ func verifyCommitmentsSum(curve Curve, commitments []commitment, point Point) er
 
 // generate commitments to x for a curve.
 // x is expressed as bits b_0 ... b_n where n == bits.
-func generateCommitments(curve Curve, x []byte, bits uint64) ([]commitment, error) {
+func generateCommitments(curve Curve, x []byte, bits uint64, random scalarSource) ([]commitment, error) {
 	// make n blinders
 	blinders := make([]Scalar, bits)
 	commitments := make([]commitment, bits)
@@ -194,7 +333,11 @@ func generateCommitments(curve Curve, x []byte, bits uint64) ([]commitment, erro
 				panic("sum of blinders is not zero")
 			}
 		} else {
-			blinders[i] = curve.NewRandomScalar()
+			blinder, err := random(curve)
+			if err != nil {
+				return nil, err
+			}
+			blinders[i] = blinder
 
 			// r_i * 2^i
 			blinderTimesPowerOfTwo := blinders[i].Mul(currPowerOfTwo)
@@ -216,9 +359,8 @@ func generateCommitments(curve Curve, x []byte, bits uint64) ([]commitment, erro
 		// generate commitment
 		// b_i * G' + r_i * G
 		b := curve.ScalarFromInt(uint32(getBit(x, i)))
-		bG := curve.ScalarBaseMul(b)
 		rG := curve.ScalarMul(blinders[i], curve.AltBasePoint())
-		c := bG.Add(rG)
+		c := curve.AddBaseMul(rG, b)
 		if c.IsZero() {
 			panic("commitment should not be zero")
 		}
@@ -243,8 +385,14 @@ func generateRingSignature(
 	curveA, curveB Curve,
 	x byte,
 	commitmentA, commitmentB commitment,
+	random scalarSource,
 ) (*ringSignature, error) {
-	j, k := curveA.NewRandomScalar(), curveB.NewRandomScalar()
+	j, k, err := randomPair(curveA, curveB, random)
+	if err != nil {
+		return nil, err
+	}
+	defer j.Zeroize()
+	defer k.Zeroize()
 
 	eA, err := hashToScalar(
 		curveA,
@@ -270,7 +418,10 @@ func generateRingSignature(
 
 	switch x {
 	case 0:
-		a0, b0 := curveA.NewRandomScalar(), curveB.NewRandomScalar()
+		a0, b0, err := randomPair(curveA, curveB, random)
+		if err != nil {
+			return nil, err
+		}
 
 		commitmentAMinusOne := commitmentA.commitment.Sub(curveA.BasePoint())
 		commitmentBMinusOne := commitmentB.commitment.Sub(curveB.BasePoint())
@@ -303,7 +454,10 @@ func generateRingSignature(
 			b1:      b1,
 		}, nil
 	case 1:
-		a1, b1 := curveA.NewRandomScalar(), curveB.NewRandomScalar()
+		a1, b1, err := randomPair(curveA, curveB, random)
+		if err != nil {
+			return nil, err
+		}
 
 		ecA := commitmentA.commitment.ScalarMul(eA)
 		ecB := commitmentB.commitment.ScalarMul(eB)
@@ -338,6 +492,21 @@ func generateRingSignature(
 	}
 }
 
+// randomPair returns a random scalar on each curve.
+func randomPair(curveA, curveB Curve, random scalarSource) (Scalar, Scalar, error) {
+	a, err := random(curveA)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, err := random(curveB)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return a, b, nil
+}
+
 func hashToScalar(curve Curve, elements ...interface{}) (Scalar, error) {
 	preimage := []byte{}
 
@@ -367,8 +536,13 @@ func min(a, b uint64) uint64 {
 
 // generateRandomBits generates up to 256 random bits.
 func generateRandomBits(bits uint64) ([32]byte, error) {
+	return readRandomBits(rand.Reader, bits)
+}
+
+// readRandomBits reads up to 256 random bits from r.
+func readRandomBits(r io.Reader, bits uint64) ([32]byte, error) {
 	x := [32]byte{}
-	_, err := rand.Read(x[:])
+	_, err := io.ReadFull(r, x[:])
 	if err != nil {
 		return x, err
 	}
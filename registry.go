@@ -0,0 +1,132 @@
+package dleq
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+	"github.com/pokt-network/go-dleq/types"
+)
+
+// Curve identifiers of the curves implemented by this module. Both secp256k1
+// backends share an identifier, since they implement the same curve with the
+// same encodings and a proof made with one verifies with the other.
+const (
+	CurveIDSecp256k1 uint8 = 1
+	CurveIDEd25519   uint8 = 2
+)
+
+type registeredCurve struct {
+	name  string
+	newFn func() types.Curve
+}
+
+var (
+	registryMu sync.RWMutex
+	curvesByID = make(map[uint8]registeredCurve)
+	idsByName  = make(map[string]uint8)
+)
+
+func init() {
+	RegisterCurve(CurveIDSecp256k1, "secp256k1", secp256k1.NewCurve)
+	RegisterCurve(CurveIDEd25519, "ed25519", ed25519.NewCurve)
+}
+
+// RegisterCurve makes a curve available by identifier, eg. for
+// deserializing a ProofBundle. name must match the curve's Name. It panics if
+// the identifier or name is already registered, or if newFn is nil.
+func RegisterCurve(id uint8, name string, newFn func() types.Curve) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if newFn == nil {
+		panic("dleq: RegisterCurve constructor is nil")
+	}
+
+	if _, ok := curvesByID[id]; ok {
+		panic(fmt.Sprintf("dleq: RegisterCurve called twice for id %d", id))
+	}
+
+	if _, ok := idsByName[name]; ok {
+		panic(fmt.Sprintf("dleq: RegisterCurve called twice for curve %q", name))
+	}
+
+	curvesByID[id] = registeredCurve{
+		name:  name,
+		newFn: newFn,
+	}
+	idsByName[name] = id
+}
+
+// CurveByID returns a new instance of the curve registered with the given
+// identifier.
+func CurveByID(id uint8) (types.Curve, error) {
+	registryMu.RLock()
+	c, ok := curvesByID[id]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown curve identifier %d", id)
+	}
+
+	return c.newFn(), nil
+}
+
+// CurveInfo describes a registered curve.
+type CurveInfo struct {
+	ID      uint8
+	Name    string
+	BitSize uint64
+
+	// Backend names the implementation of a curve with more than one: the
+	// compiled secp256k1 backend, see secp256k1.BackendName. It's empty for
+	// other curves.
+	Backend string
+
+	// New returns a new instance of the curve.
+	New func() types.Curve
+}
+
+// SupportedCurves returns the registered curves, ordered by identifier, eg.
+// to let users choose one.
+func SupportedCurves() []CurveInfo {
+	registryMu.RLock()
+	infos := make([]CurveInfo, 0, len(curvesByID))
+	for id, c := range curvesByID {
+		infos = append(infos, CurveInfo{
+			ID:   id,
+			Name: c.name,
+			New:  c.newFn,
+		})
+	}
+	registryMu.RUnlock()
+
+	slices.SortFunc(infos, func(a, b CurveInfo) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	for i := range infos {
+		curve := infos[i].New()
+		infos[i].BitSize = curve.BitSize()
+		if _, ok := curve.(*secp256k1.CurveImpl); ok {
+			infos[i].Backend = secp256k1.BackendName()
+		}
+	}
+
+	return infos
+}
+
+// curveID returns the identifier the curve is registered under.
+func curveID(c types.Curve) (uint8, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	id, ok := idsByName[c.Name()]
+	if !ok {
+		return 0, fmt.Errorf("curve %q is not registered", c.Name())
+	}
+
+	return id, nil
+}
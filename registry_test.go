@@ -0,0 +1,105 @@
+package dleq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/secp256k1"
+	"github.com/pokt-network/go-dleq/types"
+)
+
+func TestCurveByID(t *testing.T) {
+	secp, err := CurveByID(CurveIDSecp256k1)
+	require.NoError(t, err)
+	require.Equal(t, "secp256k1", secp.Name())
+
+	ed, err := CurveByID(CurveIDEd25519)
+	require.NoError(t, err)
+	require.Equal(t, "ed25519", ed.Name())
+
+	// every curve in the module round trips through its identifier
+	for _, curve := range allCurves() {
+		id, err := curveID(curve)
+		require.NoError(t, err)
+		c, err := CurveByID(id)
+		require.NoError(t, err)
+		require.Equal(t, curve.Name(), c.Name())
+		require.True(t, c.BasePoint().Equals(curve.BasePoint()))
+	}
+
+	_, err = CurveByID(0)
+	require.Error(t, err)
+	_, err = CurveByID(0xff)
+	require.Error(t, err)
+}
+
+// renamedCurve is a curve with a name that isn't registered.
+type renamedCurve struct {
+	Curve
+	name string
+}
+
+func (c *renamedCurve) Name() string {
+	return c.name
+}
+
+func TestRegisterCurve(t *testing.T) {
+	// the secp256k1 backends share an identifier, which can't be taken again
+	require.NotEqual(t, CurveIDSecp256k1, CurveIDEd25519)
+	require.Panics(t, func() {
+		RegisterCurve(CurveIDSecp256k1, "other", func() types.Curve { return nil })
+	})
+	require.Panics(t, func() {
+		RegisterCurve(200, "secp256k1", func() types.Curve { return nil })
+	})
+	require.Panics(t, func() {
+		RegisterCurve(201, "nil constructor", nil)
+	})
+
+	const testID = 250
+	newFn := func() types.Curve {
+		return &renamedCurve{Curve: allCurves()[0], name: "registry-test"}
+	}
+
+	// the registry is global, so only register once when run with -count
+	if _, err := CurveByID(testID); err != nil {
+		_, err = curveID(newFn())
+		require.Error(t, err)
+		RegisterCurve(testID, "registry-test", newFn)
+	}
+
+	c, err := CurveByID(testID)
+	require.NoError(t, err)
+	require.Equal(t, "registry-test", c.Name())
+
+	id, err := curveID(c)
+	require.NoError(t, err)
+	require.Equal(t, uint8(testID), id)
+}
+
+func TestSupportedCurves(t *testing.T) {
+	curves := SupportedCurves()
+	require.GreaterOrEqual(t, len(curves), 2)
+
+	byName := make(map[string]CurveInfo)
+	for i, info := range curves {
+		if i > 0 {
+			require.Less(t, curves[i-1].ID, info.ID)
+		}
+
+		require.Equal(t, info.Name, info.New().Name())
+		byName[info.Name] = info
+	}
+
+	secp := byName["secp256k1"]
+	require.Equal(t, CurveIDSecp256k1, secp.ID)
+	require.Equal(t, uint64(255), secp.BitSize)
+	require.Equal(t, secp256k1.BackendName(), secp.Backend)
+	require.NotEmpty(t, secp.Backend)
+
+	ed := byName["ed25519"]
+	require.Equal(t, CurveIDEd25519, ed.ID)
+	require.Equal(t, uint64(252), ed.BitSize)
+	require.Empty(t, ed.Backend)
+}
@@ -4,7 +4,75 @@ package secp256k1
 // - curve_decred.go: Pure Go (default)
 // - curve_ethereum.go: libsecp256k1 wrapper (build tag: ethereum_secp256k1)
 // - curve_ethereum_pooling.go: Memory optimization pools for Ethereum backend
+// - libsecp256k1_geth.go: Ethereum backend calls via go-ethereum's bundled libsecp256k1
+// - comb.go: variable time fixed-base comb for public scalars on the go-ethereum path, with combtable.go generated by gencombtable.go
+// - fixedbase.go: fixed-base tables for NewFixedBaseTable, shared by both backends
+// - libsecp256k1_system.go: Ethereum backend calls via a system libsecp256k1 (build tag: system_libsecp256k1)
+// - backend_decred.go, backend_nocgo.go, backend_ethereum.go: which backend NewCurve uses in each build configuration
 //
 // Build commands:
-//   CGO_ENABLED=0 go build                                    # Decred backend
-//   CGO_ENABLED=1 go build -tags="ethereum_secp256k1"        # Ethereum backend
+//   CGO_ENABLED=0 go build                                                      # Decred backend
+//   CGO_ENABLED=1 go build -tags="ethereum_secp256k1"                           # Ethereum backend
+//   CGO_ENABLED=1 go build -tags="ethereum_secp256k1 system_libsecp256k1"       # Ethereum backend, system libsecp256k1
+//
+// With the ethereum_secp256k1 tag but cgo disabled, eg. CGO_ENABLED=0 or no
+// C compiler, the Decred backend is built instead and
+// NewCurveWithBackend(BackendEthereum) returns ErrBackendUnavailable.
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Backend identifies a secp256k1 implementation.
+type Backend string
+
+const (
+	// BackendDecred is the pure Go implementation.
+	BackendDecred Backend = "decred"
+
+	// BackendEthereum calls libsecp256k1 through cgo.
+	BackendEthereum Backend = "ethereum"
+)
+
+// ErrBackendUnavailable is returned by NewCurveWithBackend when the requested
+// backend isn't compiled into the binary. The returned error wraps it with
+// the reason.
+var ErrBackendUnavailable = errors.New("secp256k1 backend unavailable")
+
+// CompiledBackend returns the backend that NewCurve uses. Backends are
+// selected at build time, see above.
+func CompiledBackend() Backend {
+	return compiledBackend
+}
+
+// BackendName returns the name of the backend NewCurve uses, "decred" or
+// "ethereum", eg. for labelling test and benchmark output.
+func BackendName() string {
+	return string(compiledBackend)
+}
+
+// EthereumBackendAvailable reports whether the Ethereum backend is compiled
+// in and, if not, why.
+func EthereumBackendAvailable() (bool, string) {
+	return compiledBackend == BackendEthereum, ethereumUnavailableReason
+}
+
+// NewCurveWithBackend returns the curve implemented by the given backend,
+// or an error wrapping ErrBackendUnavailable if that backend isn't the one
+// compiled in.
+func NewCurveWithBackend(backend Backend) (Curve, error) {
+	if backend != BackendDecred && backend != BackendEthereum {
+		return nil, fmt.Errorf("unknown secp256k1 backend %q", backend)
+	}
+
+	if backend == compiledBackend {
+		return NewCurve(), nil
+	}
+
+	if backend == BackendEthereum {
+		return nil, fmt.Errorf("%w: %s", ErrBackendUnavailable, ethereumUnavailableReason)
+	}
+
+	return nil, fmt.Errorf("%w: built with the ethereum backend", ErrBackendUnavailable)
+}
@@ -0,0 +1,9 @@
+//go:build !ethereum_secp256k1
+// +build !ethereum_secp256k1
+
+package secp256k1
+
+const (
+	compiledBackend           = BackendDecred
+	ethereumUnavailableReason = "built without the ethereum_secp256k1 tag"
+)
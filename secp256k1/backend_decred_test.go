@@ -0,0 +1,15 @@
+//go:build !ethereum_secp256k1 || !cgo
+// +build !ethereum_secp256k1 !cgo
+
+package secp256k1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackendName(t *testing.T) {
+	require.Equal(t, "decred", BackendName())
+	require.Equal(t, BackendDecred, CompiledBackend())
+}
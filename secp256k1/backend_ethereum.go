@@ -0,0 +1,9 @@
+//go:build cgo && ethereum_secp256k1
+// +build cgo,ethereum_secp256k1
+
+package secp256k1
+
+const (
+	compiledBackend           = BackendEthereum
+	ethereumUnavailableReason = ""
+)
@@ -0,0 +1,51 @@
+//go:build cgo && ethereum_secp256k1
+// +build cgo,ethereum_secp256k1
+
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	dcrsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	ethsecp256k1 "github.com/ethereum/go-ethereum/crypto/secp256k1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackendName(t *testing.T) {
+	require.Equal(t, "ethereum", BackendName())
+	require.Equal(t, BackendEthereum, CompiledBackend())
+}
+
+// TestSignatureDERToEth_GoEthereum cross-verifies the converted signatures
+// with go-ethereum.
+func TestSignatureDERToEth_GoEthereum(t *testing.T) {
+	curve := NewCurve()
+	privKey := curve.NewRandomScalar()
+	pubKey := curve.ScalarBaseMul(privKey)
+	msgPoint := curve.ScalarBaseMul(curve.NewRandomScalar())
+	hash := sha256.Sum256(msgPoint.Encode())
+
+	der, err := curve.Sign(privKey, msgPoint)
+	require.NoError(t, err)
+
+	sig, err := SignatureDERToEth(der)
+	require.NoError(t, err)
+	require.True(t, ethsecp256k1.VerifySignature(pubKey.Encode(), hash[:], sig[:]))
+
+	// go-ethereum rejects a high s, which the conversion negates
+	var s dcrsecp256k1.ModNScalar
+	s.SetByteSlice(sig[32:])
+	high := sig
+	s.Negate().PutBytesUnchecked(high[32:])
+	require.False(t, ethsecp256k1.VerifySignature(pubKey.Encode(), hash[:], high[:]))
+
+	res, err := SignatureDERToEth(CompactToDER(high))
+	require.NoError(t, err)
+	require.True(t, ethsecp256k1.VerifySignature(pubKey.Encode(), hash[:], res[:]))
+
+	// and a go-ethereum signature, without its recovery id, verifies as DER
+	ethSig, err := ethsecp256k1.Sign(hash[:], privKey.Encode())
+	require.NoError(t, err)
+	require.True(t, curve.Verify(pubKey, msgPoint, SignatureEthToDER([64]byte(ethSig[:64]))))
+}
@@ -0,0 +1,12 @@
+//go:build ethereum_secp256k1 && !cgo
+// +build ethereum_secp256k1,!cgo
+
+package secp256k1
+
+// The ethereum_secp256k1 tag was given but cgo is disabled, so the Decred
+// backend is built instead.
+const (
+	compiledBackend           = BackendDecred
+	ethereumUnavailableReason = "built with the ethereum_secp256k1 tag but cgo is disabled; " +
+		"set CGO_ENABLED=1 and install a C compiler"
+)
@@ -0,0 +1,27 @@
+//go:build ethereum_secp256k1 && !cgo
+// +build ethereum_secp256k1,!cgo
+
+package secp256k1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewCurveWithBackend_CGODisabled runs with
+// CGO_ENABLED=0 go test -tags ethereum_secp256k1 ./secp256k1
+func TestNewCurveWithBackend_CGODisabled(t *testing.T) {
+	available, reason := EthereumBackendAvailable()
+	require.False(t, available)
+	require.Contains(t, reason, "cgo is disabled")
+
+	_, err := NewCurveWithBackend(BackendEthereum)
+	require.ErrorIs(t, err, ErrBackendUnavailable)
+	require.ErrorContains(t, err, "cgo is disabled")
+
+	// the Decred backend is built instead
+	curve, err := NewCurveWithBackend(BackendDecred)
+	require.NoError(t, err)
+	require.NoError(t, curve.ValidatePoint(curve.BasePoint()))
+}
@@ -0,0 +1,35 @@
+package secp256k1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCurveWithBackend(t *testing.T) {
+	curve, err := NewCurveWithBackend(CompiledBackend())
+	require.NoError(t, err)
+	require.Equal(t, "secp256k1", curve.Name())
+
+	available, reason := EthereumBackendAvailable()
+	curve, err = NewCurveWithBackend(BackendEthereum)
+	if available {
+		require.Equal(t, BackendEthereum, CompiledBackend())
+		require.Empty(t, reason)
+		require.NoError(t, err)
+		require.NotNil(t, curve)
+
+		_, err = NewCurveWithBackend(BackendDecred)
+		require.ErrorIs(t, err, ErrBackendUnavailable)
+	} else {
+		require.Equal(t, BackendDecred, CompiledBackend())
+		require.NotEmpty(t, reason)
+		require.ErrorIs(t, err, ErrBackendUnavailable)
+		require.ErrorContains(t, err, reason)
+		require.Nil(t, curve)
+	}
+
+	_, err = NewCurveWithBackend("openssl")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrBackendUnavailable)
+}
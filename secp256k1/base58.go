@@ -0,0 +1,84 @@
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var errInvalidChecksum = errors.New("invalid base58check checksum")
+
+// base58CheckEncode returns the Bitcoin base58check encoding of payload,
+// ie. the base58 encoding of payload followed by the first four bytes of
+// its double SHA-256.
+func base58CheckEncode(payload []byte) string {
+	checksum := doubleSHA256(payload)
+	b := append(append([]byte{}, payload...), checksum[:4]...)
+
+	n := new(big.Int).SetBytes(b)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+
+	var out []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+
+	// each leading zero byte is encoded as a leading '1'
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+
+		out = append(out, base58Alphabet[0])
+	}
+
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+
+	return string(out)
+}
+
+// base58CheckDecode decodes a base58check string and returns the payload
+// without the checksum.
+func base58CheckDecode(s string) ([]byte, error) {
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	for _, c := range []byte(s) {
+		d := strings.IndexByte(base58Alphabet, c)
+		if d < 0 {
+			return nil, errors.New("invalid base58 character")
+		}
+
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(d)))
+	}
+
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	b := append(make([]byte, zeros), n.Bytes()...)
+	if len(b) < 4 {
+		return nil, errors.New("base58check string too short")
+	}
+
+	payload, checksum := b[:len(b)-4], b[len(b)-4:]
+	expected := doubleSHA256(payload)
+	if string(checksum) != string(expected[:4]) {
+		return nil, errInvalidChecksum
+	}
+
+	return payload, nil
+}
+
+func doubleSHA256(b []byte) [32]byte {
+	h := sha256.Sum256(b)
+	return sha256.Sum256(h[:])
+}
@@ -0,0 +1,76 @@
+package secp256k1
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// VerifyXOnly verifies a BIP-340 Schnorr signature over msg against a 32-byte
+// x-only public key, as used by Taproot. The key is lifted to the point with
+// that x coordinate and even Y.
+//
+// An error is returned if the key or signature are malformed, including when
+// x is not the x coordinate of any curve point. A well-formed signature that
+// doesn't verify returns false and no error.
+func VerifyXOnly(xonly [32]byte, msg, sig []byte) (bool, error) {
+	if len(sig) != 64 {
+		return false, errors.New("invalid signature length")
+	}
+
+	curve := NewCurve().(*CurveImpl)
+
+	var enc [33]byte
+	enc[0] = 0x02
+	copy(enc[1:], xonly[:])
+	P, err := curve.DecodeToPoint(enc[:])
+	if err != nil {
+		return false, fmt.Errorf("invalid x-only public key: %w", err)
+	}
+
+	r, sBytes := sig[:32], sig[32:]
+	if new(big.Int).SetBytes(sBytes).Cmp(curve.order) >= 0 {
+		return false, nil
+	}
+
+	s, err := curve.DecodeToScalar(sBytes)
+	if err != nil {
+		return false, err
+	}
+
+	// e = int(hash_BIP0340/challenge(r || P || m)) mod n
+	h := taggedHash("BIP0340/challenge", r, xonly[:], msg)
+	var wide [64]byte
+	copy(wide[32:], h[:])
+	e := curve.ReduceWide(wide)
+
+	// R = s*G - e*P
+	R := curve.scalarBaseMulVartime(s).Sub(curve.ScalarMul(e, P))
+	if R.IsZero() {
+		return false, nil
+	}
+
+	encR := R.Encode()
+	if encR[0] != 0x02 {
+		return false, nil
+	}
+
+	return bytes.Equal(encR[1:], r), nil
+}
+
+// taggedHash computes the BIP-340 tagged hash SHA256(SHA256(tag) || SHA256(tag) || msg...).
+func taggedHash(tag string, msgs ...[]byte) [32]byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, m := range msgs {
+		h.Write(m)
+	}
+
+	var out [32]byte
+	h.Sum(out[:0])
+	return out
+}
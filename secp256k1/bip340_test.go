@@ -0,0 +1,118 @@
+package secp256k1
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// BIP-340 test vectors, see https://github.com/bitcoin/bips/blob/master/bip-0340/test-vectors.csv
+func TestVerifyXOnly(t *testing.T) {
+	testCases := []struct {
+		name   string
+		pubkey string
+		msg    string
+		sig    string
+		valid  bool
+		errors bool
+	}{
+		{
+			name:   "vector 0",
+			pubkey: "f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9",
+			msg:    "0000000000000000000000000000000000000000000000000000000000000000",
+			sig:    "e907831f80848d1069a5371b402410364bdf1c5f8307b0084c55f1ce2dca821525f66a4a85ea8b71e482a74f382d2ce5ebeee8fdb2172f477df4900d310536c0",
+			valid:  true,
+		},
+		{
+			name:   "vector 1",
+			pubkey: "dff1d77f2a671c5f36183726db2341be58feae1da2deced843240f7b502ba659",
+			msg:    "243f6a8885a308d313198a2e03707344a4093822299f31d0082efa98ec4e6c89",
+			sig:    "6896bd60eeae296db48a229ff71dfe071bde413e6d43f917dc8dcf8c78de33418906d11ac976abccb20b091292bff4ea897efcb639ea871cfa95f6de339e4b0a",
+			valid:  true,
+		},
+		{
+			name:   "vector 5: public key not on the curve",
+			pubkey: "eefdea4cdb677750a420fee807eacf21eb9898ae79b9768766e4faa04a2d4a34",
+			msg:    "243f6a8885a308d313198a2e03707344a4093822299f31d0082efa98ec4e6c89",
+			sig:    "6cff5c3ba86c69ea4b7376f31a9bcb4f74c1976089b2d9963da2e5543e17776969e89b4c5564d00349106b8497785dd7d1d713a8ae82b32fa79d5f7fc407d39b",
+			errors: true,
+		},
+		{
+			name:   "vector 6: R has an odd y coordinate",
+			pubkey: "dff1d77f2a671c5f36183726db2341be58feae1da2deced843240f7b502ba659",
+			msg:    "243f6a8885a308d313198a2e03707344a4093822299f31d0082efa98ec4e6c89",
+			sig:    "fff97bd5755eeea420453a14355235d382f6472f8568a18b2f057a14602975563cc27944640ac607cd107ae10923d9ef7a73c643e166be5ebeafa34b1ac553e2",
+		},
+		{
+			name:   "vector 7: negated message",
+			pubkey: "dff1d77f2a671c5f36183726db2341be58feae1da2deced843240f7b502ba659",
+			msg:    "243f6a8885a308d313198a2e03707344a4093822299f31d0082efa98ec4e6c89",
+			sig:    "1fa62e331edbc21c394792d2ab1100a7b432b013df3f6ff4f99fcb33e0e1515f28890b3edb6e7189b630448b515ce4f8622a954cfe545735aaea5134fccdb2bd",
+		},
+		{
+			name:   "vector 8: negated s",
+			pubkey: "dff1d77f2a671c5f36183726db2341be58feae1da2deced843240f7b502ba659",
+			msg:    "243f6a8885a308d313198a2e03707344a4093822299f31d0082efa98ec4e6c89",
+			sig:    "6cff5c3ba86c69ea4b7376f31a9bcb4f74c1976089b2d9963da2e5543e177769961764b3aa9b2ffcb6ef947b6887a226e8d7c93e00c5ed0c1834ff0d0c2e6da6",
+		},
+		{
+			name:   "vector 9: sG - eP is infinity, r = 0",
+			pubkey: "dff1d77f2a671c5f36183726db2341be58feae1da2deced843240f7b502ba659",
+			msg:    "243f6a8885a308d313198a2e03707344a4093822299f31d0082efa98ec4e6c89",
+			sig:    "0000000000000000000000000000000000000000000000000000000000000000123dda8328af9c23a94c1feecfd123ba4fb73476f0d594dcb65c6425bd186051",
+		},
+		{
+			name:   "vector 10: sG - eP is infinity, r = 1",
+			pubkey: "dff1d77f2a671c5f36183726db2341be58feae1da2deced843240f7b502ba659",
+			msg:    "243f6a8885a308d313198a2e03707344a4093822299f31d0082efa98ec4e6c89",
+			sig:    "00000000000000000000000000000000000000000000000000000000000000017615fbaf5ae28864013c099742deadb4dba87f11ac6754f93780d5a1837cf197",
+		},
+		{
+			name:   "vector 11: r is not the x coordinate of a point",
+			pubkey: "dff1d77f2a671c5f36183726db2341be58feae1da2deced843240f7b502ba659",
+			msg:    "243f6a8885a308d313198a2e03707344a4093822299f31d0082efa98ec4e6c89",
+			sig:    "4a298dacae57395a15d0795ddbfd1dcb564da82b0f269bc70a74f8220429ba1d69e89b4c5564d00349106b8497785dd7d1d713a8ae82b32fa79d5f7fc407d39b",
+		},
+		{
+			name:   "vector 12: r equal to the field size",
+			pubkey: "dff1d77f2a671c5f36183726db2341be58feae1da2deced843240f7b502ba659",
+			msg:    "243f6a8885a308d313198a2e03707344a4093822299f31d0082efa98ec4e6c89",
+			sig:    "fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f69e89b4c5564d00349106b8497785dd7d1d713a8ae82b32fa79d5f7fc407d39b",
+		},
+		{
+			name:   "vector 13: s equal to the curve order",
+			pubkey: "dff1d77f2a671c5f36183726db2341be58feae1da2deced843240f7b502ba659",
+			msg:    "243f6a8885a308d313198a2e03707344a4093822299f31d0082efa98ec4e6c89",
+			sig:    "6cff5c3ba86c69ea4b7376f31a9bcb4f74c1976089b2d9963da2e5543e177769fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141",
+		},
+		{
+			name:   "vector 14: public key exceeds the field size",
+			pubkey: "fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc30",
+			msg:    "243f6a8885a308d313198a2e03707344a4093822299f31d0082efa98ec4e6c89",
+			sig:    "6cff5c3ba86c69ea4b7376f31a9bcb4f74c1976089b2d9963da2e5543e17776969e89b4c5564d00349106b8497785dd7d1d713a8ae82b32fa79d5f7fc407d39b",
+			errors: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var pubkey [32]byte
+			_, err := hex.Decode(pubkey[:], []byte(tc.pubkey))
+			require.NoError(t, err)
+			msg, err := hex.DecodeString(tc.msg)
+			require.NoError(t, err)
+			sig, err := hex.DecodeString(tc.sig)
+			require.NoError(t, err)
+
+			ok, err := VerifyXOnly(pubkey, msg, sig)
+			if tc.errors {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.valid, ok)
+		})
+	}
+}
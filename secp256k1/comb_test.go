@@ -0,0 +1,112 @@
+//go:build cgo && ethereum_secp256k1 && !system_libsecp256k1
+// +build cgo,ethereum_secp256k1,!system_libsecp256k1
+
+package secp256k1
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	dcrsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	ethsecp256k1 "github.com/ethereum/go-ethereum/crypto/secp256k1"
+)
+
+// TestCombScalarBaseMul checks the comb against go-ethereum's ScalarBaseMult.
+func TestCombScalarBaseMul(t *testing.T) {
+	curve := ethsecp256k1.S256()
+	nMinusOne := new(big.Int).Sub(curve.N, big.NewInt(1))
+
+	var scalars [][]byte
+	for _, k := range []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(255), nMinusOne} {
+		scalars = append(scalars, k.FillBytes(make([]byte, 32)))
+	}
+
+	// every single bit, which selects one tooth of one comb
+	for bit := 0; bit < 256; bit++ {
+		k := new(big.Int).Lsh(big.NewInt(1), uint(bit))
+		if k.Cmp(curve.N) < 0 {
+			scalars = append(scalars, k.FillBytes(make([]byte, 32)))
+		}
+	}
+
+	for i := 0; i < 1024; i++ {
+		k, err := rand.Int(rand.Reader, nMinusOne)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		k.Add(k, big.NewInt(1))
+		scalars = append(scalars, k.FillBytes(make([]byte, 32)))
+	}
+
+	for _, k := range scalars {
+		x, y := combScalarBaseMul(k)
+		ex, ey := curve.ScalarBaseMult(append([]byte{}, k...))
+		if x.Cmp(ex) != 0 || y.Cmp(ey) != 0 {
+			t.Fatalf("ScalarBaseMul mismatch for %x", k)
+		}
+	}
+
+	// zero and out of range scalars give no point, like go-ethereum
+	for _, k := range [][]byte{make([]byte, 32), curve.N.FillBytes(make([]byte, 32)), make([]byte, 31)} {
+		if x, y := combScalarBaseMul(k); x != nil || y != nil {
+			t.Fatalf("expected no point for %x", k)
+		}
+	}
+}
+
+// TestCombTable checks every entry of the generated table.
+func TestCombTable(t *testing.T) {
+	table := loadCombTable()
+	for c := range table {
+		for v := 1; v < 1<<combTeeth; v++ {
+			k := new(big.Int)
+			for i := 0; i < combTeeth; i++ {
+				if v>>i&1 == 1 {
+					k.SetBit(k, i*combSpacing+c*combBlock, 1)
+				}
+			}
+
+			var s dcrsecp256k1.ModNScalar
+			s.SetByteSlice(k.FillBytes(make([]byte, 32)))
+
+			var expected dcrsecp256k1.JacobianPoint
+			dcrsecp256k1.ScalarBaseMultNonConst(&s, &expected)
+			expected.ToAffine()
+
+			entry := &table[c][v-1]
+			if !entry.X.Equals(&expected.X) || !entry.Y.Equals(&expected.Y) || !entry.Z.IsOne() {
+				t.Fatalf("wrong table entry %d of comb %d", v-1, c)
+			}
+		}
+	}
+}
+
+// The comb should be at least as fast as the Decred backend's ScalarBaseMul
+// (BenchmarkComparison_ScalarBaseMul without the ethereum_secp256k1 tag).
+func BenchmarkScalarBaseMul_Comb(b *testing.B) {
+	var k [32]byte
+	_, _ = rand.Read(k[:])
+	k[0] &= 0x7f
+	loadCombTable()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = combScalarBaseMul(k[:])
+	}
+}
+
+func BenchmarkScalarBaseMul_GoEthereum(b *testing.B) {
+	var k [32]byte
+	_, _ = rand.Read(k[:])
+	k[0] &= 0x7f
+	buf := make([]byte, 32)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// go-ethereum clears the scalar after use
+		copy(buf, k[:])
+		_, _ = ethsecp256k1.S256().ScalarBaseMult(buf)
+	}
+}
@@ -0,0 +1,43 @@
+package secp256k1
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// hashToScalarVectors are pinned HashToScalar outputs, the big-endian
+// encoding of SHA3-512(in) reduced modulo the group order. Proofs only
+// verify across backends if both compute exactly these.
+var hashToScalarVectors = []struct {
+	in, out string
+}{
+	{"", "fbb1afc68c6713b20ff809b865ea3142c6caa99155e259107a8b84e6ec5106e0"},
+	{"616263", "9fd139279e510d9d547d5053dfd00ffe5a5f243bf2ebbb6989c9df31ed9934dc"},
+	{"676f2d646c6571", "12eead755641f1e5c13a502db122a00fdac9e147a5a2beceb2fe5d6540978ff1"},
+	{"000102ff", "455afa916b7b93f54737bd8aceccc93d8b1fb865f056620e1f24a84f8057b1ef"},
+}
+
+// VerifyHashToScalarConsistency checks the compiled backend's HashToScalar
+// against pinned outputs, which every backend must produce for proofs to
+// verify across backends. It returns an error describing the first
+// mismatch.
+func VerifyHashToScalarConsistency() error {
+	curve := NewCurve()
+	for _, v := range hashToScalarVectors {
+		in, err := hex.DecodeString(v.in)
+		if err != nil {
+			return err
+		}
+
+		s, err := curve.HashToScalar(in)
+		if err != nil {
+			return fmt.Errorf("failed to hash %x: %w", in, err)
+		}
+
+		if out := hex.EncodeToString(s.Encode()); out != v.out {
+			return fmt.Errorf("%s backend hashes %x to %s, expected %s", CompiledBackend(), in, out, v.out)
+		}
+	}
+
+	return nil
+}
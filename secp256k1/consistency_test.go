@@ -0,0 +1,19 @@
+package secp256k1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyHashToScalarConsistency(t *testing.T) {
+	require.NoError(t, VerifyHashToScalarConsistency())
+
+	// a changed hash or reduction shows up as a mismatch
+	saved := hashToScalarVectors
+	defer func() { hashToScalarVectors = saved }()
+
+	hashToScalarVectors = append(saved[:1:1], saved[1:]...)
+	hashToScalarVectors[0].out = saved[1].out
+	require.ErrorContains(t, VerifyHashToScalarConsistency(), "expected "+saved[1].out)
+}
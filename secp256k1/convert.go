@@ -0,0 +1,30 @@
+package secp256k1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pokt-network/go-dleq/types"
+)
+
+// ConvertPoint returns p as a point of the secp256k1 backend this package
+// was built with. p can be any point that encodes like a compressed
+// secp256k1 point, eg. one from another backend or one wrapped by another
+// type; it's converted by encoding and decoding it. An error is returned if
+// p isn't a secp256k1 point.
+func ConvertPoint(p types.Point) (types.Point, error) {
+	if p == nil {
+		return nil, errors.New("point is nil")
+	}
+
+	if pp, ok := p.(*PointImpl); ok {
+		return pp.Copy(), nil
+	}
+
+	res, err := NewCurve().DecodeToPoint(p.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("not a secp256k1 point: %w", err)
+	}
+
+	return res, nil
+}
@@ -1,19 +1,24 @@
-//go:build !ethereum_secp256k1
-// +build !ethereum_secp256k1
+//go:build !ethereum_secp256k1 || !cgo
+// +build !ethereum_secp256k1 !cgo
 
 package secp256k1
 
 import (
-	"crypto/ecdsa"
+	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"io"
 	"math/big"
+	"math/bits"
 
 	"github.com/pokt-network/go-dleq/types"
 
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	dcrecdsa "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
 	"golang.org/x/crypto/sha3"
 )
 
@@ -76,6 +81,14 @@ func altBasePoint() Point {
 	}
 }
 
+func (*CurveImpl) Name() string {
+	return "secp256k1"
+}
+
+func (c *CurveImpl) Equal(other types.Curve) bool {
+	return types.CurvesEqual(c, other)
+}
+
 func (*CurveImpl) BitSize() uint64 {
 	return 255
 }
@@ -84,7 +97,37 @@ func (*CurveImpl) CompressedPointSize() int {
 	return 33
 }
 
+func (*CurveImpl) ScalarSize() int {
+	return 32
+}
+
+func (c *CurveImpl) Order() *big.Int {
+	return new(big.Int).Set(c.order)
+}
+
+func (c *CurveImpl) OrderMinusOne() Scalar {
+	return c.ScalarFromInt(1).Negate()
+}
+
+func (c *CurveImpl) HalfOrder() Scalar {
+	var b [32]byte
+	new(big.Int).Rsh(c.order, 1).FillBytes(b[:])
+
+	s := new(secp256k1.ModNScalar)
+	s.SetBytes(&b)
+	return &ScalarImpl{
+		inner: s,
+	}
+}
+
+// DecodeToPoint decodes a 33-byte compressed point. ParsePubKey also
+// accepts uncompressed points, which are rejected here like in the Ethereum
+// backend.
 func (*CurveImpl) DecodeToPoint(in []byte) (Point, error) {
+	if len(in) != 33 {
+		return nil, errors.New("invalid compressed point length")
+	}
+
 	cp := make([]byte, len(in))
 	copy(cp, in)
 	pub, err := secp256k1.ParsePubKey(cp)
@@ -100,6 +143,61 @@ func (*CurveImpl) DecodeToPoint(in []byte) (Point, error) {
 	}, nil
 }
 
+// DecodeToPointUnchecked decompresses the point without ParsePubKey's
+// checks. The decompressed coordinates are already affine, so it sets Z = 1
+// and skips the ToAffine call, and its field inversion, that DecodeToPoint
+// makes. An x of the field prime or more is reduced, and an x with no square
+// root gives a point off the curve.
+func (*CurveImpl) DecodeToPointUnchecked(in []byte) (Point, error) {
+	if len(in) != 33 {
+		return nil, errors.New("invalid compressed point length")
+	}
+
+	if in[0] != secp256k1.PubKeyFormatCompressedEven && in[0] != secp256k1.PubKeyFormatCompressedOdd {
+		return nil, errors.New("invalid compressed point format")
+	}
+
+	r := new(secp256k1.JacobianPoint)
+	r.X.SetByteSlice(in[1:])
+	r.X.Normalize()
+	_ = secp256k1.DecompressY(&r.X, in[0] == secp256k1.PubKeyFormatCompressedOdd, &r.Y)
+	r.Y.Normalize()
+	r.Z.SetInt(1)
+	return &PointImpl{
+		inner: r,
+	}, nil
+}
+
+// DecodeToPoints decodes the points into one backing array. ParsePubKey
+// only accepts points on the curve, and the identity has no compressed
+// encoding, so every decoded point is valid. AsJacobian already gives
+// affine coordinates with Z = 1, so unlike DecodeToPoint this skips
+// ToAffine, which would still pay a field inversion per point.
+func (*CurveImpl) DecodeToPoints(in []byte, count int) ([]Point, error) {
+	if count < 0 || len(in) != count*33 {
+		return nil, fmt.Errorf("invalid length %d for %d points", len(in), count)
+	}
+
+	cp := bytes.Clone(in)
+	inner := make([]secp256k1.JacobianPoint, count)
+	impls := make([]PointImpl, count)
+	points := make([]Point, count)
+	for i := range points {
+		pub, err := secp256k1.ParsePubKey(cp[i*33 : (i+1)*33])
+		if err != nil {
+			return nil, &types.PointDecodeError{Index: i, Err: err}
+		}
+
+		pub.AsJacobian(&inner[i])
+		impls[i].inner = &inner[i]
+		points[i] = &impls[i]
+	}
+
+	return points, nil
+}
+
+// DecodeToScalar decodes a 32-byte big-endian scalar. It rejects
+// non-canonical encodings, ie. values of the group order or more.
 func (*CurveImpl) DecodeToScalar(in []byte) (Scalar, error) {
 	if len(in) != 32 {
 		return nil, errors.New("invalid scalar length")
@@ -108,7 +206,10 @@ func (*CurveImpl) DecodeToScalar(in []byte) (Scalar, error) {
 	cp := make([]byte, len(in))
 	copy(cp, in)
 	s := new(secp256k1.ModNScalar)
-	_ = s.SetByteSlice(cp)
+	if s.SetByteSlice(cp) {
+		return nil, errors.New("invalid scalar; not below the group order")
+	}
+
 	return &ScalarImpl{
 		inner: s,
 	}, nil
@@ -118,22 +219,62 @@ func (c *CurveImpl) BasePoint() Point {
 	return c.basePoint
 }
 
+func (*CurveImpl) Identity() Point {
+	return &PointImpl{
+		inner: new(secp256k1.JacobianPoint),
+	}
+}
+
+// SumPoints returns the sum of the given points. The sum is accumulated in
+// Jacobian coordinates and only converted to affine once.
+func (*CurveImpl) SumPoints(points ...Point) Point {
+	sum := new(secp256k1.JacobianPoint)
+	for _, p := range points {
+		pp, ok := p.(*PointImpl)
+		if !ok {
+			panic("invalid point; type is not *secp256k1.PointImpl")
+		}
+
+		secp256k1.AddNonConst(sum, pp.inner, sum)
+	}
+
+	sum.ToAffine()
+	return &PointImpl{
+		inner: sum,
+	}
+}
+
 func (c *CurveImpl) AltBasePoint() Point {
 	return c.altBasePoint
 }
 
-func (*CurveImpl) NewRandomScalar() Scalar {
-	var b [32]byte
-	_, err := rand.Read(b[:])
+func (c *CurveImpl) IsGenerator(p Point) (isG, isH bool) {
+	if _, ok := p.(*PointImpl); !ok {
+		return false, false
+	}
+
+	return c.basePoint.Equals(p), c.altBasePoint.Equals(p)
+}
+
+// NewRandomScalar returns a uniformly random scalar in [1, n).
+func (c *CurveImpl) NewRandomScalar() Scalar {
+	return c.newRandomScalar(rand.Reader)
+}
+
+// newRandomScalar rejection samples a scalar from r: 32 bytes of randomness
+// that are zero or not below the group order are discarded and read again,
+// rather than reduced, which would bias the result.
+func (c *CurveImpl) newRandomScalar(r io.Reader) Scalar {
+	s, err := types.SampleScalarBelow(r, c, c.order)
 	if err != nil {
 		panic(err)
 	}
 
-	s := new(secp256k1.ModNScalar)
-	s.SetBytes(&b)
-	return &ScalarImpl{
-		inner: s,
-	}
+	return s
+}
+
+func (c *CurveImpl) RandomScalarBelow(bound *big.Int) (Scalar, error) {
+	return types.SampleScalarBelow(rand.Reader, c, bound)
 }
 
 func reverse(in [32]byte) [32]byte {
@@ -163,22 +304,55 @@ func (*CurveImpl) ScalarFromInt(in uint32) Scalar {
 	}
 }
 
+func (c *CurveImpl) ValidateSecretStrength(secret [32]byte) error {
+	return types.ValidateSecretStrength(c, secret)
+}
+
 func (c *CurveImpl) HashToScalar(in []byte) (Scalar, error) {
 	h := sha3.Sum512(in)
-	n := new(big.Int).SetBytes(h[:])
-	n = new(big.Int).Mod(n, c.order)
-	var reduced [32]byte
-	copy(reduced[:], n.Bytes())
+	return c.ReduceWide(h), nil
+}
+
+// ReduceWide reduces the big-endian integer b modulo the curve order.
+func (c *CurveImpl) ReduceWide(b [64]byte) Scalar {
+	return c.reduce(b[:])
+}
 
+// SplitReduce reduces each big-endian 32-byte half of b modulo the curve order.
+func (c *CurveImpl) SplitReduce(b [64]byte) (Scalar, Scalar) {
+	return c.reduce(b[:32]), c.reduce(b[32:])
+}
+
+// twoPow256ModN is 2^256 modulo the curve order.
+var twoPow256ModN = func() secp256k1.ModNScalar {
+	var s secp256k1.ModNScalar
+	s.SetByteSlice([]byte{
+		0x01, 0x45, 0x51, 0x23, 0x19, 0x50, 0xb7, 0x5f, 0xc4,
+		0x40, 0x2d, 0xa1, 0x73, 0x2f, 0xc9, 0xbe, 0xbf,
+	})
+	return s
+}()
+
+// reduce reduces the big-endian integer in, of up to 64 bytes, modulo the
+// curve order without going through big.Int.
+func (*CurveImpl) reduce(in []byte) Scalar {
 	s := new(secp256k1.ModNScalar)
-	wasReduced := s.SetBytes(&reduced)
-	if wasReduced != 0 {
-		panic("hash should not be reduced twice")
+	if len(in) <= 32 {
+		s.SetByteSlice(in)
+		return &ScalarImpl{
+			inner: s,
+		}
 	}
 
+	// in = hi * 2^256 + lo
+	var lo secp256k1.ModNScalar
+	s.SetByteSlice(in[:len(in)-32])
+	lo.SetByteSlice(in[len(in)-32:])
+	s.Mul(&twoPow256ModN).Add(&lo)
+
 	return &ScalarImpl{
 		inner: s,
-	}, nil
+	}
 }
 
 func (*CurveImpl) ScalarBaseMul(s Scalar) Point {
@@ -195,6 +369,141 @@ func (*CurveImpl) ScalarBaseMul(s Scalar) Point {
 	}
 }
 
+// scalarBaseMulVartime computes s*G for a public s. ScalarBaseMul is
+// already variable time on this backend.
+func (c *CurveImpl) scalarBaseMulVartime(s Scalar) Point {
+	return c.ScalarBaseMul(s)
+}
+
+// AddBaseMul computes p + s*G in Jacobian coordinates, so only the sum is
+// converted to affine rather than s*G as well.
+func (*CurveImpl) AddBaseMul(p Point, s Scalar) Point {
+	pp, ok := p.(*PointImpl)
+	if !ok {
+		panic("invalid point; type is not *secp256k1.PointImpl")
+	}
+
+	ss, ok := s.(*ScalarImpl)
+	if !ok {
+		panic("invalid scalar; type is not *secp256k1.ScalarImpl")
+	}
+
+	return addBaseMul(pp, ss.inner)
+}
+
+// SubBaseMul computes p + (-s)*G, see AddBaseMul.
+func (*CurveImpl) SubBaseMul(p Point, s Scalar) Point {
+	pp, ok := p.(*PointImpl)
+	if !ok {
+		panic("invalid point; type is not *secp256k1.PointImpl")
+	}
+
+	ss, ok := s.(*ScalarImpl)
+	if !ok {
+		panic("invalid scalar; type is not *secp256k1.ScalarImpl")
+	}
+
+	var minusS secp256k1.ModNScalar
+	minusS.NegateVal(ss.inner)
+	return addBaseMul(pp, &minusS)
+}
+
+func addBaseMul(p *PointImpl, s *secp256k1.ModNScalar) Point {
+	var sG secp256k1.JacobianPoint
+	secp256k1.ScalarBaseMultNonConst(s, &sG)
+
+	r := new(secp256k1.JacobianPoint)
+	secp256k1.AddNonConst(p.inner, &sG, r)
+	r.ToAffine()
+	return &PointImpl{
+		inner: r,
+	}
+}
+
+// MultiScalarMul returns the sum of scalars[i]*points[i] with Pippenger's
+// bucket method and the given window size, in Jacobian coordinates,
+// converting to affine once at the end. It's used by types.MultiScalarMul,
+// which validates the arguments.
+func (*CurveImpl) MultiScalarMul(scalars []Scalar, points []Point, windowBits int) Point {
+	digits := make([][32]byte, len(scalars))
+	inner := make([]*secp256k1.JacobianPoint, len(points))
+	for i := range scalars {
+		ss, ok := scalars[i].(*ScalarImpl)
+		if !ok {
+			panic("invalid scalar; type is not *secp256k1.ScalarImpl")
+		}
+
+		pp, ok := points[i].(*PointImpl)
+		if !ok {
+			panic("invalid point; type is not *secp256k1.PointImpl")
+		}
+
+		digits[i] = ss.inner.Bytes()
+		inner[i] = pp.inner
+	}
+
+	buckets := make([]secp256k1.JacobianPoint, 1<<windowBits-1)
+	acc := new(secp256k1.JacobianPoint)
+	var running, total secp256k1.JacobianPoint
+	for window := (256+windowBits-1)/windowBits - 1; window >= 0; window-- {
+		for i := 0; i < windowBits; i++ {
+			secp256k1.DoubleNonConst(acc, acc)
+		}
+
+		clear(buckets)
+		for i, p := range inner {
+			d := windowDigitBE(&digits[i], window*windowBits, windowBits)
+			if d != 0 {
+				secp256k1.AddNonConst(&buckets[d-1], p, &buckets[d-1])
+			}
+		}
+
+		// sum of d * buckets[d-1]
+		running, total = secp256k1.JacobianPoint{}, secp256k1.JacobianPoint{}
+		for d := len(buckets) - 1; d >= 0; d-- {
+			secp256k1.AddNonConst(&running, &buckets[d], &running)
+			secp256k1.AddNonConst(&total, &running, &total)
+		}
+
+		secp256k1.AddNonConst(acc, &total, acc)
+	}
+
+	acc.ToAffine()
+	return &PointImpl{
+		inner: acc,
+	}
+}
+
+// windowDigitBE returns the w bits of the big-endian integer be starting at
+// bit offset, counting from the least significant bit.
+func windowDigitBE(be *[32]byte, offset, w int) int {
+	d := 0
+	for i := 0; i < w && offset+i < 256; i++ {
+		bit := offset + i
+		d |= int(be[31-bit/8]>>(bit%8)&1) << i
+	}
+
+	return d
+}
+
+// BaseMulSmall computes k*G by double-and-add over the bits of k in Jacobian
+// coordinates, converting to affine once at the end.
+func (c *CurveImpl) BaseMulSmall(k uint32) Point {
+	g := c.basePoint.(*PointImpl).inner
+	r := new(secp256k1.JacobianPoint)
+	for i := bits.Len32(k) - 1; i >= 0; i-- {
+		secp256k1.DoubleNonConst(r, r)
+		if k>>i&1 == 1 {
+			secp256k1.AddNonConst(r, g, r)
+		}
+	}
+
+	r.ToAffine()
+	return &PointImpl{
+		inner: r,
+	}
+}
+
 func (*CurveImpl) ScalarMul(s Scalar, p Point) Point {
 	ss, ok := s.(*ScalarImpl)
 	if !ok {
@@ -214,32 +523,134 @@ func (*CurveImpl) ScalarMul(s Scalar, p Point) Point {
 	}
 }
 
+func (*CurveImpl) NewFixedBaseTable(p Point) types.FixedBaseTable {
+	pp, ok := p.(*PointImpl)
+	if !ok {
+		panic("invalid point; type is not *secp256k1.PointImpl")
+	}
+
+	return &fixedBase{
+		table: newFixedBaseTable(pp.inner),
+	}
+}
+
+type fixedBase struct {
+	table *fixedBaseTable
+}
+
+func (f *fixedBase) ScalarMul(s Scalar) Point {
+	ss, ok := s.(*ScalarImpl)
+	if !ok {
+		panic("invalid scalar; type is not *secp256k1.ScalarImpl")
+	}
+
+	k := ss.Bytes()
+	point := new(secp256k1.JacobianPoint)
+	f.table.mul(&k, point)
+	toAffine(point)
+	return &PointImpl{
+		inner: point,
+	}
+}
+
 // Sign accepts a private key `s` and signs the encoded point `p`.
-func (*CurveImpl) Sign(s Scalar, p Point) ([]byte, error) {
+func (c *CurveImpl) Sign(s Scalar, p Point) ([]byte, error) {
+	return c.SignHash(s, sha256.Sum256(p.Encode()))
+}
+
+// SignHash signs a precomputed 32-byte message hash, like go-ethereum's
+// Sign but without the recovery id. Sign is SignHash of the SHA-256 digest
+// of the point's encoding. The signature is DER encoded.
+func (*CurveImpl) SignHash(s Scalar, hash [32]byte) ([]byte, error) {
+	ss, ok := s.(*ScalarImpl)
+	if !ok {
+		panic("invalid scalar; type is not *secp256k1.ScalarImpl")
+	}
+
+	// the nonce is derived with RFC6979, so signing is deterministic and
+	// matches the ethereum backend
+	sk := secp256k1.NewPrivateKey(ss.inner)
+	return dcrecdsa.Sign(sk, hash[:]).Serialize(), nil
+}
+
+// SignCompact is like Sign, but returns the signature in its 64-byte r || s
+// form instead of DER.
+func (*CurveImpl) SignCompact(s Scalar, p Point) ([64]byte, error) {
 	ss, ok := s.(*ScalarImpl)
 	if !ok {
 		panic("invalid scalar; type is not *secp256k1.ScalarImpl")
 	}
 
 	sk := secp256k1.NewPrivateKey(ss.inner)
-	key := sk.ToECDSA()
-	msg := p.Encode()
-	hash := sha256.Sum256(msg)
-	return ecdsa.SignASN1(rand.Reader, key, hash[:])
+	hash := sha256.Sum256(p.Encode())
+
+	// the compact form is prefixed by a recovery code, which isn't needed
+	var out [64]byte
+	copy(out[:], dcrecdsa.SignCompact(sk, hash[:], true)[1:])
+	return out, nil
+}
+
+// VerifyCompact verifies a 64-byte r || s signature made by SignCompact.
+func (c *CurveImpl) VerifyCompact(pubkey, msgPoint Point, sig [64]byte) bool {
+	return c.verifyCompactHash(pubkey, sha256.Sum256(msgPoint.Encode()), sig)
+}
+
+// VerifyHash verifies a strict DER signature of a precomputed 32-byte
+// message hash, made by SignHash.
+func (c *CurveImpl) VerifyHash(pubkey Point, hash [32]byte, sig []byte) bool {
+	compact, err := DERToCompact(sig)
+	if err != nil {
+		return false
+	}
+
+	return c.verifyCompactHash(pubkey, hash, compact)
 }
 
-func (*CurveImpl) Verify(pubkey, msgPoint Point, sig []byte) bool {
+func (*CurveImpl) verifyCompactHash(pubkey Point, hash [32]byte, sig [64]byte) bool {
 	pp, ok := pubkey.(*PointImpl)
 	if !ok {
 		panic("invalid point; type is not *secp256k1.PointImpl")
 	}
 
-	pp.inner.ToAffine()
-	pub := secp256k1.NewPublicKey(&pp.inner.X, &pp.inner.Y)
+	var r, s secp256k1.ModNScalar
+	if r.SetByteSlice(sig[:32]) || s.SetByteSlice(sig[32:]) || r.IsZero() || s.IsZero() {
+		return false
+	}
 
-	msg := msgPoint.Encode()
-	hash := sha256.Sum256(msg)
-	return ecdsa.VerifyASN1(pub.ToECDSA(), hash[:], sig)
+	affine := pp.affine()
+	pub := secp256k1.NewPublicKey(&affine.X, &affine.Y)
+	return dcrecdsa.NewSignature(&r, &s).Verify(hash[:], pub)
+}
+
+// Verify verifies a strict DER signature made by Sign.
+func (c *CurveImpl) Verify(pubkey, msgPoint Point, sig []byte) bool {
+	compact, err := DERToCompact(sig)
+	if err != nil {
+		return false
+	}
+
+	return c.VerifyCompact(pubkey, msgPoint, compact)
+}
+
+// ValidatePoint checks that the point is a secp256k1 point, is not the
+// point at infinity and lies on the curve. secp256k1 has cofactor 1, so
+// every such point is in the prime-order group.
+func (*CurveImpl) ValidatePoint(p Point) error {
+	pp, ok := p.(*PointImpl)
+	if !ok || pp.inner == nil {
+		return errors.New("invalid point; type is not *secp256k1.PointImpl")
+	}
+
+	if pp.IsZero() {
+		return errors.New("point is the point at infinity")
+	}
+
+	affine := pp.affine()
+	if !secp256k1.NewPublicKey(&affine.X, &affine.Y).IsOnCurve() {
+		return errors.New("point is not on the curve")
+	}
+
+	return nil
 }
 
 type ScalarImpl struct {
@@ -258,6 +669,25 @@ func (s *ScalarImpl) Add(b Scalar) Scalar {
 	}
 }
 
+func (s *ScalarImpl) AddWithCarry(b Scalar) (Scalar, bool) {
+	sum := s.Add(b).(*ScalarImpl)
+
+	// both inputs are below the order, so the sum wrapped iff it's now
+	// smaller than either of them
+	x, y := s.inner.Bytes(), sum.inner.Bytes()
+	return sum, lessThan(&y, &x)
+}
+
+// lessThan reports whether a < b for 256-bit big-endian integers, in
+// constant time.
+func lessThan(a, b *[32]byte) bool {
+	var borrow uint64
+	for i := 24; i >= 0; i -= 8 {
+		_, borrow = bits.Sub64(binary.BigEndian.Uint64(a[i:]), binary.BigEndian.Uint64(b[i:]), borrow)
+	}
+	return borrow == 1
+}
+
 func (s *ScalarImpl) Sub(b Scalar) Scalar {
 	ss, ok := b.(*ScalarImpl)
 	if !ok {
@@ -299,12 +729,49 @@ func (s *ScalarImpl) Inverse() Scalar {
 	}
 }
 
+func (s *ScalarImpl) Div(b Scalar) (Scalar, error) {
+	ss, ok := b.(*ScalarImpl)
+	if !ok {
+		panic("invalid scalar; type is not *secp256k1.ScalarImpl")
+	}
+
+	if ss.inner.IsZero() {
+		return nil, types.ErrZeroInverse
+	}
+
+	r := new(secp256k1.ModNScalar).Set(ss.inner)
+	r.InverseNonConst().Mul(s.inner)
+	return &ScalarImpl{
+		inner: r,
+	}, nil
+}
+
+// InverseConstantTime inverts the scalar by exponentiation, unlike Inverse
+// which uses a faster variable time algorithm.
+func (s *ScalarImpl) InverseConstantTime() (Scalar, error) {
+	if s.inner.IsZero() {
+		return nil, types.ErrZeroInverse
+	}
+
+	return &ScalarImpl{
+		inner: invertConstantTime(s.inner),
+	}, nil
+}
+
 func (s *ScalarImpl) Encode() []byte {
 	var b [32]byte
 	s.inner.PutBytes(&b)
 	return b[:]
 }
 
+// Bytes is like Encode, but returns the big-endian encoding as an array, so
+// it doesn't need to be allocated on the heap.
+func (s *ScalarImpl) Bytes() [32]byte {
+	var b [32]byte
+	s.inner.PutBytes(&b)
+	return b
+}
+
 func (s *ScalarImpl) Eq(other Scalar) bool {
 	o, ok := other.(*ScalarImpl)
 	if !ok {
@@ -318,6 +785,40 @@ func (s *ScalarImpl) IsZero() bool {
 	return s.inner.IsZero()
 }
 
+// Normalize returns a copy of the scalar; ModNScalar is always reduced.
+func (s *ScalarImpl) Normalize() Scalar {
+	return &ScalarImpl{
+		inner: new(secp256k1.ModNScalar).Set(s.inner),
+	}
+}
+
+func (s *ScalarImpl) Zeroize() {
+	s.inner.Zero()
+}
+
+func (s *ScalarImpl) SetUint64(v uint64) Scalar {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	if s.inner == nil {
+		s.inner = new(secp256k1.ModNScalar)
+	}
+	s.inner.SetByteSlice(b[:])
+	return s
+}
+
+// SetBytes sets the scalar to the big-endian b, reduced modulo n.
+func (s *ScalarImpl) SetBytes(b []byte) error {
+	if len(b) != 32 {
+		return errors.New("invalid scalar length")
+	}
+
+	if s.inner == nil {
+		s.inner = new(secp256k1.ModNScalar)
+	}
+	s.inner.SetByteSlice(b)
+	return nil
+}
+
 type PointImpl struct {
 	inner *secp256k1.JacobianPoint
 }
@@ -361,11 +862,10 @@ func (p *PointImpl) Sub(b Point) Point {
 		panic("invalid point; type is not *secp256k1.PointImpl")
 	}
 
-	minusOne := new(secp256k1.ModNScalar)
-	minusOne.SetInt(1)
-	minusOne.Negate()
+	// -(x, y, z) = (x, -y, z), which leaves the point at infinity unchanged
 	minusP := new(secp256k1.JacobianPoint)
-	secp256k1.ScalarMultNonConst(minusOne, pp.inner, minusP)
+	minusP.Set(pp.inner)
+	minusP.Y.Normalize().Negate(1).Normalize()
 
 	r := new(secp256k1.JacobianPoint)
 	secp256k1.AddNonConst(p.inner, minusP, r)
@@ -389,18 +889,77 @@ func (p *PointImpl) ScalarMul(s Scalar) Point {
 	}
 }
 
+// Normalize converts the point to affine coordinates in place. Points
+// returned by this package are already affine, in which case it's a no-op.
+func (p *PointImpl) Normalize() {
+	if !p.inner.Z.IsOne() {
+		p.inner.ToAffine()
+	}
+}
+
+// affine returns the point in affine coordinates without modifying p, so
+// that points can be read concurrently. It only copies the point if it's
+// not already affine.
+func (p *PointImpl) affine() *secp256k1.JacobianPoint {
+	if p.inner.Z.IsOne() {
+		return p.inner
+	}
+
+	r := new(secp256k1.JacobianPoint)
+	r.Set(p.inner)
+	r.ToAffine()
+	return r
+}
+
 func (p *PointImpl) Encode() []byte {
-	p.inner.ToAffine()
-	return secp256k1.NewPublicKey(&p.inner.X, &p.inner.Y).SerializeCompressed()
+	a := p.affine()
+	return secp256k1.NewPublicKey(&a.X, &a.Y).SerializeCompressed()
 }
 
+// IsZero reports whether the point is the point at infinity. Like dcrd, a
+// Jacobian point with Z = 0 is the point at infinity, as is (0, 0), which is
+// what the point at infinity becomes when converted to affine.
 func (p *PointImpl) IsZero() bool {
-	zeroFieldVal := new(secp256k1.FieldVal).SetInt(0)
-	zero := secp256k1.NewPublicKey(zeroFieldVal, zeroFieldVal)
+	if p.inner.Z.IsZero() {
+		return true
+	}
+
+	return p.inner.X.IsZero() && p.inner.Y.IsZero()
+}
+
+var _ types.PointEncodeInto = &PointImpl{}
 
-	p.inner.ToAffine()
-	pub := secp256k1.NewPublicKey(&p.inner.X, &p.inner.Y)
-	return pub.IsEqual(zero)
+func (p *PointImpl) EncodeInto(dst []byte) (int, error) {
+	if len(dst) < 33 {
+		return 0, types.ErrBufferTooSmall
+	}
+
+	a := p.affine()
+	dst[0] = 0x02
+	if a.Y.IsOdd() {
+		dst[0] = 0x03
+	}
+	a.X.PutBytesUnchecked(dst[1:33])
+	return 33, nil
+}
+
+func (p *PointImpl) EqualsBytes(enc []byte) bool {
+	if len(enc) != 33 {
+		return false
+	}
+
+	a := p.affine()
+	prefix := byte(0x02)
+	if a.Y.IsOdd() {
+		prefix = 0x03
+	}
+	if enc[0] != prefix {
+		return false
+	}
+
+	var x [32]byte
+	a.X.PutBytes(&x)
+	return bytes.Equal(x[:], enc[1:])
 }
 
 func (p *PointImpl) Equals(other Point) bool {
@@ -409,11 +968,9 @@ func (p *PointImpl) Equals(other Point) bool {
 		panic("invalid point; type is not *secp256k1.PointImpl")
 	}
 
-	p.inner.ToAffine()
-	ppub := secp256k1.NewPublicKey(&p.inner.X, &p.inner.Y)
-
-	pp.inner.ToAffine()
-	otherPub := secp256k1.NewPublicKey(&pp.inner.X, &pp.inner.Y)
+	a, b := p.affine(), pp.affine()
+	ppub := secp256k1.NewPublicKey(&a.X, &a.Y)
+	otherPub := secp256k1.NewPublicKey(&b.X, &b.Y)
 
 	return ppub.IsEqual(otherPub)
 }
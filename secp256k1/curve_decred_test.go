@@ -0,0 +1,77 @@
+//go:build !ethereum_secp256k1 || !cgo
+// +build !ethereum_secp256k1 !cgo
+
+package secp256k1
+
+import (
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPointImpl_Identity(t *testing.T) {
+	curve := NewCurve()
+	P := curve.ScalarBaseMul(curve.NewRandomScalar())
+
+	// the point at infinity in each of its representations
+	var anyXY secp256k1.JacobianPoint
+	anyXY.X.SetInt(1)
+	anyXY.Y.SetInt(2)
+	identities := []Point{
+		curve.Identity(),
+		&PointImpl{inner: &anyXY},
+		P.Sub(P),
+	}
+
+	for _, O := range identities {
+		require.True(t, O.IsZero())
+		require.True(t, P.Add(O).Equals(P))
+		require.True(t, O.Add(P).Equals(P))
+		require.True(t, P.Sub(O).Equals(P))
+		require.True(t, O.Sub(P).Equals(P.ScalarMul(curve.ScalarFromInt(1).Negate())))
+		require.True(t, O.Add(O).IsZero())
+		require.True(t, O.Sub(O).IsZero())
+		require.True(t, O.ScalarMul(curve.NewRandomScalar()).IsZero())
+	}
+
+	require.True(t, P.Sub(P).IsZero())
+	require.False(t, P.IsZero())
+	require.False(t, curve.BasePoint().IsZero())
+
+	// Sub negates directly instead of multiplying by -1
+	Q := curve.ScalarBaseMul(curve.NewRandomScalar())
+	require.True(t, P.Sub(Q).Add(Q).Equals(P))
+	require.True(t, P.Sub(Q).Equals(P.Add(Q.ScalarMul(curve.ScalarFromInt(1).Negate()))))
+}
+
+func TestPointImpl_Normalize(t *testing.T) {
+	curve := NewCurve()
+	P := curve.ScalarBaseMul(curve.NewRandomScalar()).(*PointImpl)
+	require.True(t, P.inner.Z.IsOne())
+
+	// the same point with Z = 2, ie. (4x, 8y, 2)
+	var jacobian secp256k1.JacobianPoint
+	jacobian.Set(P.inner)
+	jacobian.X.MulInt(4).Normalize()
+	jacobian.Y.MulInt(8).Normalize()
+	jacobian.Z.SetInt(2)
+	Q := &PointImpl{inner: &jacobian}
+
+	// reading the point doesn't modify it
+	require.True(t, Q.Equals(P))
+	require.Equal(t, P.Encode(), Q.Encode())
+	require.True(t, Q.EqualsBytes(P.Encode()))
+	require.NoError(t, curve.ValidatePoint(Q))
+	require.False(t, Q.inner.Z.IsOne())
+
+	Q.Normalize()
+	require.True(t, Q.inner.Z.IsOne())
+	require.True(t, Q.inner.X.Equals(&P.inner.X))
+	require.True(t, Q.inner.Y.Equals(&P.inner.Y))
+
+	// and it's a no-op for affine points
+	before := *P.inner
+	P.Normalize()
+	require.Equal(t, before, *P.inner)
+}
@@ -4,12 +4,15 @@
 package secp256k1
 
 import (
+	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	"io"
 	"math/big"
 
+	dcrsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	ethsecp256k1 "github.com/ethereum/go-ethereum/crypto/secp256k1"
 	"golang.org/x/crypto/sha3"
 
@@ -68,7 +71,10 @@ func altBasePoint() Point {
 	}
 
 	x := new(big.Int).SetBytes(b[1:])
-	y := decompressPoint(x, b[0] == 0x03)
+	y, err := decompressPoint(x, b[0] == 0x03)
+	if err != nil {
+		panic(err)
+	}
 
 	return &PointImpl{
 		x: x,
@@ -77,11 +83,15 @@ func altBasePoint() Point {
 }
 
 // decompressPoint recovers Y coordinate from X coordinate
-func decompressPoint(x *big.Int, isOdd bool) *big.Int {
+func decompressPoint(x *big.Int, isOdd bool) (*big.Int, error) {
 	// secp256k1: y² = x³ + 7
 	curve := ethsecp256k1.S256()
 	p := curve.Params().P
 
+	if x.Cmp(p) >= 0 {
+		return nil, errors.New("invalid point: x coordinate is not reduced")
+	}
+
 	// Compute x³ + 7
 	x3 := new(big.Int).Mul(x, x)
 	x3.Mul(x3, x)
@@ -91,7 +101,7 @@ func decompressPoint(x *big.Int, isOdd bool) *big.Int {
 	// Compute square root
 	y := new(big.Int).ModSqrt(x3, p)
 	if y == nil {
-		panic("invalid point: no square root")
+		return nil, errors.New("invalid point: no square root")
 	}
 
 	// Choose correct sign
@@ -99,7 +109,15 @@ func decompressPoint(x *big.Int, isOdd bool) *big.Int {
 		y.Sub(p, y)
 	}
 
-	return y
+	return y, nil
+}
+
+func (*CurveImpl) Name() string {
+	return "secp256k1"
+}
+
+func (c *CurveImpl) Equal(other types.Curve) bool {
+	return types.CurvesEqual(c, other)
 }
 
 func (*CurveImpl) BitSize() uint64 {
@@ -110,6 +128,26 @@ func (*CurveImpl) CompressedPointSize() int {
 	return 33
 }
 
+func (*CurveImpl) ScalarSize() int {
+	return 32
+}
+
+func (c *CurveImpl) Order() *big.Int {
+	return new(big.Int).Set(c.order)
+}
+
+func (c *CurveImpl) OrderMinusOne() Scalar {
+	return &ScalarImpl{
+		value: new(big.Int).Sub(c.order, big.NewInt(1)),
+	}
+}
+
+func (c *CurveImpl) HalfOrder() Scalar {
+	return &ScalarImpl{
+		value: new(big.Int).Rsh(c.order, 1),
+	}
+}
+
 func (*CurveImpl) DecodeToPoint(in []byte) (Point, error) {
 	if len(in) != 33 {
 		return nil, errors.New("invalid compressed point length")
@@ -123,7 +161,41 @@ func (*CurveImpl) DecodeToPoint(in []byte) (Point, error) {
 	}
 
 	x := new(big.Int).SetBytes(cp[1:])
-	y := decompressPoint(x, cp[0] == 0x03)
+	y, err := decompressPoint(x, cp[0] == 0x03)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PointImpl{
+		x: x,
+		y: y,
+	}, nil
+}
+
+// DecodeToPointUnchecked decompresses the point without the checks of
+// DecodeToPoint: an x of the field prime or more is reduced, and y is taken
+// as (x³ + 7)^((p+1)/4) without checking that it squares back, so an x with
+// no square root gives a point off the curve.
+func (*CurveImpl) DecodeToPointUnchecked(in []byte) (Point, error) {
+	if len(in) != 33 {
+		return nil, errors.New("invalid compressed point length")
+	}
+
+	if in[0] != 0x02 && in[0] != 0x03 {
+		return nil, errors.New("invalid compressed point format")
+	}
+
+	p := ethsecp256k1.S256().Params().P
+	x := new(big.Int).SetBytes(in[1:])
+	x.Mod(x, p)
+
+	y := new(big.Int).Mul(x, x)
+	y.Mul(y, x)
+	y.Add(y, big.NewInt(7))
+	y.Exp(y, sqrtExponent, p)
+	if (y.Bit(0) == 1) != (in[0] == 0x03) {
+		y.Sub(p, y)
+	}
 
 	return &PointImpl{
 		x: x,
@@ -131,16 +203,28 @@ func (*CurveImpl) DecodeToPoint(in []byte) (Point, error) {
 	}, nil
 }
 
+// sqrtExponent is (p+1)/4: as p = 3 mod 4, a^((p+1)/4) is a square root of
+// a whenever a has one.
+var sqrtExponent = new(big.Int).Rsh(new(big.Int).Add(ethsecp256k1.S256().Params().P, big.NewInt(1)), 2)
+
+func (c *CurveImpl) DecodeToPoints(in []byte, count int) ([]Point, error) {
+	return types.DecodeToPoints(c, in, count)
+}
+
+// DecodeToScalar decodes a 32-byte big-endian scalar. It rejects
+// non-canonical encodings, ie. values of the group order or more.
 func (*CurveImpl) DecodeToScalar(in []byte) (Scalar, error) {
 	if len(in) != 32 {
 		return nil, errors.New("invalid scalar length")
 	}
 
-	cp := make([]byte, len(in))
-	copy(cp, in)
+	value := new(big.Int).SetBytes(in)
+	if value.Cmp(ethsecp256k1.S256().Params().N) >= 0 {
+		return nil, errors.New("invalid scalar; not below the group order")
+	}
 
 	return &ScalarImpl{
-		value: new(big.Int).SetBytes(cp),
+		value: value,
 	}, nil
 }
 
@@ -148,20 +232,45 @@ func (c *CurveImpl) BasePoint() Point {
 	return c.basePoint
 }
 
+// Identity returns the point at infinity, represented as (0, 0).
+func (*CurveImpl) Identity() Point {
+	return &PointImpl{
+		x: big.NewInt(0),
+		y: big.NewInt(0),
+	}
+}
+
 func (c *CurveImpl) AltBasePoint() Point {
 	return c.altBasePoint
 }
 
-func (*CurveImpl) NewRandomScalar() Scalar {
-	var b [32]byte
-	_, err := rand.Read(b[:])
+func (c *CurveImpl) IsGenerator(p Point) (isG, isH bool) {
+	if _, ok := p.(*PointImpl); !ok {
+		return false, false
+	}
+
+	return c.basePoint.Equals(p), c.altBasePoint.Equals(p)
+}
+
+// NewRandomScalar returns a uniformly random scalar in [1, n).
+func (c *CurveImpl) NewRandomScalar() Scalar {
+	return c.newRandomScalar(rand.Reader)
+}
+
+// newRandomScalar rejection samples a scalar from r: 32 bytes of randomness
+// that are zero or not below the group order are discarded and read again,
+// rather than reduced, which would bias the result.
+func (c *CurveImpl) newRandomScalar(r io.Reader) Scalar {
+	s, err := types.SampleScalarBelow(r, c, c.order)
 	if err != nil {
 		panic(err)
 	}
 
-	return &ScalarImpl{
-		value: new(big.Int).SetBytes(b[:]),
-	}
+	return s
+}
+
+func (c *CurveImpl) RandomScalarBelow(bound *big.Int) (Scalar, error) {
+	return types.SampleScalarBelow(rand.Reader, c, bound)
 }
 
 func reverse(in [32]byte) [32]byte {
@@ -187,17 +296,33 @@ func (*CurveImpl) ScalarFromInt(in uint32) Scalar {
 	}
 }
 
+func (c *CurveImpl) ValidateSecretStrength(secret [32]byte) error {
+	return types.ValidateSecretStrength(c, secret)
+}
+
 func (c *CurveImpl) HashToScalar(in []byte) (Scalar, error) {
 	h := sha3.Sum512(in)
-	n := new(big.Int).SetBytes(h[:])
-	n = new(big.Int).Mod(n, c.order)
+	return c.ReduceWide(h), nil
+}
+
+// ReduceWide reduces the big-endian integer b modulo the curve order.
+func (c *CurveImpl) ReduceWide(b [64]byte) Scalar {
+	return c.reduce(b[:])
+}
 
+// SplitReduce reduces each big-endian 32-byte half of b modulo the curve order.
+func (c *CurveImpl) SplitReduce(b [64]byte) (Scalar, Scalar) {
+	return c.reduce(b[:32]), c.reduce(b[32:])
+}
+
+func (c *CurveImpl) reduce(in []byte) Scalar {
+	n := new(big.Int).SetBytes(in)
 	return &ScalarImpl{
-		value: n,
-	}, nil
+		value: n.Mod(n, c.order),
+	}
 }
 
-// ScalarBaseMul uses go-ethereum's optimized scalar base multiplication
+// ScalarBaseMul uses libsecp256k1's constant time base multiplication
 func (*CurveImpl) ScalarBaseMul(s Scalar) Point {
 	ss, ok := s.(*ScalarImpl)
 	if !ok {
@@ -210,10 +335,29 @@ func (*CurveImpl) ScalarBaseMul(s Scalar) Point {
 	defer putBytes32(scalarBytes)
 	ss.value.FillBytes(scalarBytes)
 
-	// Use Ethereum's optimized scalar base multiplication
-	// TODO_OPTIMIZE: ScalarBaseMul is currently slower than Decred (43μs vs 36μs)
-	// Consider using direct libsecp256k1 calls instead of go-ethereum wrapper
-	x, y := ethsecp256k1.S256().ScalarBaseMult(scalarBytes)
+	// Build with the system_libsecp256k1 tag to call a system libsecp256k1
+	// directly instead of going through the go-ethereum wrapper
+	x, y := nativeScalarBaseMul(scalarBytes)
+
+	return &PointImpl{
+		x: x,
+		y: y,
+	}
+}
+
+// scalarBaseMulVartime computes s*G for a public s, see
+// nativeScalarBaseMulVartime.
+func (*CurveImpl) scalarBaseMulVartime(s Scalar) Point {
+	ss, ok := s.(*ScalarImpl)
+	if !ok {
+		panic("invalid scalar; type is not *secp256k1.ScalarImpl")
+	}
+
+	scalarBytes := getBytes32()
+	defer putBytes32(scalarBytes)
+	ss.value.FillBytes(scalarBytes)
+
+	x, y := nativeScalarBaseMulVartime(scalarBytes)
 
 	return &PointImpl{
 		x: x,
@@ -221,6 +365,35 @@ func (*CurveImpl) ScalarBaseMul(s Scalar) Point {
 	}
 }
 
+func (c *CurveImpl) AddBaseMul(p Point, s Scalar) Point {
+	return p.Add(c.ScalarBaseMul(s))
+}
+
+func (c *CurveImpl) SubBaseMul(p Point, s Scalar) Point {
+	return p.Sub(c.ScalarBaseMul(s))
+}
+
+// MultiScalarMul returns the sum of scalars[i]*points[i]. It's used by
+// types.MultiScalarMul, which validates the arguments. Adding big.Int affine
+// points costs a field inversion, so bucketing the points is slower than a
+// libsecp256k1 multiplication per point; windowBits is ignored and the
+// products are summed instead.
+func (c *CurveImpl) MultiScalarMul(scalars []Scalar, points []Point, _ int) Point {
+	sum := c.Identity()
+	for i := range scalars {
+		sum = sum.Add(c.ScalarMul(scalars[i], points[i]))
+	}
+
+	return sum
+}
+
+// BaseMulSmall computes k*G. libsecp256k1's base multiplication already uses
+// precomputed tables, so it's cheaper than a double-and-add over
+// big.Int affine coordinates even for small k.
+func (c *CurveImpl) BaseMulSmall(k uint32) Point {
+	return c.ScalarBaseMul(c.ScalarFromInt(k))
+}
+
 // ScalarMul uses go-ethereum's optimized scalar multiplication
 // TODO_IMPROVE: Add nil checks for s and p parameters to prevent runtime panics
 func (*CurveImpl) ScalarMul(s Scalar, p Point) Point {
@@ -241,8 +414,14 @@ func (*CurveImpl) ScalarMul(s Scalar, p Point) Point {
 	defer putBytes32(scalarBytes)
 	ss.value.FillBytes(scalarBytes)
 
-	// Use Ethereum's optimized scalar multiplication
-	x, y := ethsecp256k1.S256().ScalarMult(pp.x, pp.y, scalarBytes)
+	if pp.IsZero() {
+		return &PointImpl{
+			x: big.NewInt(0),
+			y: big.NewInt(0),
+		}
+	}
+
+	x, y := nativeScalarMul(pp.x, pp.y, scalarBytes)
 
 	return &PointImpl{
 		x: x,
@@ -250,144 +429,182 @@ func (*CurveImpl) ScalarMul(s Scalar, p Point) Point {
 	}
 }
 
-// Sign accepts a private key `s` and signs the encoded point `p`.
-func (*CurveImpl) Sign(s Scalar, p Point) ([]byte, error) {
+// NewFixedBaseTable builds the table with dcrd's field arithmetic, since
+// libsecp256k1 only exposes multiplication by arbitrary points.
+func (*CurveImpl) NewFixedBaseTable(p Point) types.FixedBaseTable {
+	pp, ok := p.(*PointImpl)
+	if !ok {
+		panic("invalid point; type is not *secp256k1.PointImpl")
+	}
+
+	var point dcrsecp256k1.JacobianPoint
+	point.X.SetByteSlice(pp.x.Bytes())
+	point.Y.SetByteSlice(pp.y.Bytes())
+	point.Z.SetInt(1)
+	return &fixedBase{
+		table: newFixedBaseTable(&point),
+	}
+}
+
+type fixedBase struct {
+	table *fixedBaseTable
+}
+
+func (f *fixedBase) ScalarMul(s Scalar) Point {
 	ss, ok := s.(*ScalarImpl)
 	if !ok {
 		panic("invalid scalar; type is not *secp256k1.ScalarImpl")
 	}
 
-	// Convert scalar to 32-byte private key using pooled buffer
-	privKeyBytes := getBytes32()
-	defer putBytes32(privKeyBytes)
-	ss.value.FillBytes(privKeyBytes)
-
-	// Get message to sign
-	msg := p.Encode()
-	hash := sha256.Sum256(msg)
+	k := ss.Bytes()
+	var point dcrsecp256k1.JacobianPoint
+	f.table.mul(&k, &point)
+	x, y := affineBig(&point)
+	return &PointImpl{
+		x: x,
+		y: y,
+	}
+}
 
-	// Use Ethereum's secp256k1 signing
-	sig, err := ethsecp256k1.Sign(hash[:], privKeyBytes)
+// Sign accepts a private key `s` and signs the encoded point `p`.
+// The signature is DER encoded.
+func (c *CurveImpl) Sign(s Scalar, p Point) ([]byte, error) {
+	sig, err := c.SignCompact(s, p)
 	if err != nil {
 		return nil, err
 	}
 
-	// Convert to DER format for compatibility using pooled big.Int
-	r := getBigInt()
-	defer putBigInt(r)
-	r.SetBytes(sig[:32])
-
-	s2 := getBigInt()
-	defer putBigInt(s2)
-	s2.SetBytes(sig[32:64])
-
-	return encodeDER(r, s2), nil
+	return CompactToDER(sig), nil
 }
 
-func (*CurveImpl) Verify(pubkey, msgPoint Point, sig []byte) bool {
-	pp, ok := pubkey.(*PointImpl)
+// SignCompact is like Sign, but returns the signature in its native 64-byte
+// r || s form, avoiding the DER encoding.
+func (c *CurveImpl) SignCompact(s Scalar, p Point) ([64]byte, error) {
+	pp, ok := p.(*PointImpl)
 	if !ok {
 		panic("invalid point; type is not *secp256k1.PointImpl")
 	}
 
-	// Decode DER signature
-	r, s, err := decodeDER(sig)
+	var msg [33]byte
+	_, err := pp.EncodeInto(msg[:])
 	if err != nil {
-		return false
+		return [64]byte{}, err
 	}
 
-	// Convert to Ethereum format (64 bytes) using pooled buffer
-	ethSig := getBytes64()
-	defer putBytes64(ethSig)
-	r.FillBytes(ethSig[:32])
-	s.FillBytes(ethSig[32:64])
-
-	// Encode public key using pooled buffer
-	pubKeyBytes := getBytes65()
-	defer putBytes65(pubKeyBytes)
-	pubKeyBytes[0] = 0x04 // uncompressed
-	pp.x.FillBytes(pubKeyBytes[1:33])
-	pp.y.FillBytes(pubKeyBytes[33:65])
+	return c.signCompactHash(s, sha256.Sum256(msg[:]))
+}
 
-	msg := msgPoint.Encode()
-	hash := sha256.Sum256(msg)
+// SignHash signs a precomputed 32-byte message hash, like go-ethereum's
+// Sign but without the recovery id. Sign is SignHash of the SHA-256 digest
+// of the point's encoding. The signature is DER encoded.
+func (c *CurveImpl) SignHash(s Scalar, hash [32]byte) ([]byte, error) {
+	sig, err := c.signCompactHash(s, hash)
+	if err != nil {
+		return nil, err
+	}
 
-	// Use Ethereum's verification
-	return ethsecp256k1.VerifySignature(pubKeyBytes, hash[:], ethSig)
+	return CompactToDER(sig), nil
 }
 
-// encodeDER encodes r,s signature components in DER format
-func encodeDER(r, s *big.Int) []byte {
-	rBytes := r.Bytes()
-	sBytes := s.Bytes()
-
-	// Add leading zero if high bit is set
-	if len(rBytes) > 0 && rBytes[0] >= 0x80 {
-		rBytes = append([]byte{0}, rBytes...)
-	}
-	if len(sBytes) > 0 && sBytes[0] >= 0x80 {
-		sBytes = append([]byte{0}, sBytes...)
+func (*CurveImpl) signCompactHash(s Scalar, hash [32]byte) ([64]byte, error) {
+	ss, ok := s.(*ScalarImpl)
+	if !ok {
+		panic("invalid scalar; type is not *secp256k1.ScalarImpl")
 	}
 
-	totalLen := 4 + len(rBytes) + len(sBytes) // 4 bytes for DER headers
+	// Convert scalar to 32-byte private key using pooled buffer
+	privKeyBytes := getBytes32()
+	defer putBytes32(privKeyBytes)
+	ss.value.FillBytes(privKeyBytes)
+
+	var out [64]byte
+	sig, err := nativeSign(hash[:], privKeyBytes)
+	if err != nil {
+		return out, err
+	}
 
-	der := make([]byte, 0, totalLen+2)
-	der = append(der, 0x30, byte(totalLen))    // SEQUENCE header
-	der = append(der, 0x02, byte(len(rBytes))) // INTEGER header for r
-	der = append(der, rBytes...)
-	der = append(der, 0x02, byte(len(sBytes))) // INTEGER header for s
-	der = append(der, sBytes...)
+	copy(out[:], sig)
+	return out, nil
+}
 
-	return der
+// VerifyCompact verifies a 64-byte r || s signature made by SignCompact.
+func (c *CurveImpl) VerifyCompact(pubkey, msgPoint Point, sig [64]byte) bool {
+	return c.verifyCompactHash(pubkey, sha256.Sum256(msgPoint.Encode()), sig)
 }
 
-// decodeDER decodes DER signature to r,s components
-func decodeDER(sig []byte) (*big.Int, *big.Int, error) {
-	if len(sig) < 6 {
-		return nil, nil, errors.New("signature too short")
+// VerifyHash verifies a strict DER signature of a precomputed 32-byte
+// message hash, made by SignHash.
+func (c *CurveImpl) VerifyHash(pubkey Point, hash [32]byte, sig []byte) bool {
+	compact, err := DERToCompact(sig)
+	if err != nil {
+		return false
 	}
 
-	if sig[0] != 0x30 {
-		return nil, nil, errors.New("invalid DER signature")
+	return c.verifyCompactHash(pubkey, hash, compact)
+}
+
+func (*CurveImpl) verifyCompactHash(pubkey Point, hash [32]byte, sig [64]byte) bool {
+	pp, ok := pubkey.(*PointImpl)
+	if !ok {
+		panic("invalid point; type is not *secp256k1.PointImpl")
 	}
 
-	offset := 2
-	if offset >= len(sig) {
-		return nil, nil, errors.New("invalid signature")
+	if pp.x == nil || pp.y == nil {
+		return false
 	}
 
-	// Read r
-	if sig[offset] != 0x02 {
-		return nil, nil, errors.New("invalid r component")
+	// libsecp256k1 rejects these too, but don't rely on the native library
+	// for it, like the Decred backend
+	var r, s dcrsecp256k1.ModNScalar
+	if r.SetByteSlice(sig[:32]) || s.SetByteSlice(sig[32:]) || r.IsZero() || s.IsZero() {
+		return false
 	}
-	offset++
 
-	rLen := int(sig[offset])
-	offset++
+	var pubKeyBytes [65]byte
+	pubKeyBytes[0] = 0x04 // uncompressed
+	pp.x.FillBytes(pubKeyBytes[1:33])
+	pp.y.FillBytes(pubKeyBytes[33:65])
+
+	return nativeVerify(pubKeyBytes[:], hash[:], sig[:])
+}
 
-	if offset+rLen > len(sig) {
-		return nil, nil, errors.New("invalid r length")
+// Verify verifies a strict DER signature made by Sign.
+func (c *CurveImpl) Verify(pubkey, msgPoint Point, sig []byte) bool {
+	compact, err := DERToCompact(sig)
+	if err != nil {
+		return false
 	}
 
-	r := new(big.Int).SetBytes(sig[offset : offset+rLen])
-	offset += rLen
+	return c.VerifyCompact(pubkey, msgPoint, compact)
+}
+
+// ValidatePoint checks that the point is a secp256k1 point, is not the
+// point at infinity and lies on the curve. secp256k1 has cofactor 1, so
+// every such point is in the prime-order group.
+func (*CurveImpl) ValidatePoint(p Point) error {
+	pp, ok := p.(*PointImpl)
+	if !ok {
+		return errors.New("invalid point; type is not *secp256k1.PointImpl")
+	}
 
-	// Read s
-	if offset >= len(sig) || sig[offset] != 0x02 {
-		return nil, nil, errors.New("invalid s component")
+	if pp.IsZero() {
+		return errors.New("point is the point at infinity")
 	}
-	offset++
 
-	sLen := int(sig[offset])
-	offset++
+	if pp.x == nil || pp.y == nil {
+		return errors.New("point coordinates are not set")
+	}
 
-	if offset+sLen != len(sig) {
-		return nil, nil, errors.New("invalid s length")
+	curve := ethsecp256k1.S256()
+	if pp.x.Cmp(curve.Params().P) >= 0 || pp.y.Cmp(curve.Params().P) >= 0 {
+		return errors.New("point coordinates are not reduced")
 	}
 
-	s := new(big.Int).SetBytes(sig[offset:])
+	if !curve.IsOnCurve(pp.x, pp.y) {
+		return errors.New("point is not on the curve")
+	}
 
-	return r, s, nil
+	return nil
 }
 
 type ScalarImpl struct {
@@ -410,6 +627,22 @@ func (s *ScalarImpl) Add(b Scalar) Scalar {
 	}
 }
 
+func (s *ScalarImpl) AddWithCarry(b Scalar) (Scalar, bool) {
+	ss, ok := b.(*ScalarImpl)
+	if !ok {
+		panic("invalid scalar; type is not *secp256k1.ScalarImpl")
+	}
+
+	result := new(big.Int).Add(s.value, ss.value)
+	n := ethsecp256k1.S256().Params().N
+	reduced := result.Cmp(n) >= 0
+	result.Mod(result, n)
+
+	return &ScalarImpl{
+		value: result,
+	}, reduced
+}
+
 func (s *ScalarImpl) Sub(b Scalar) Scalar {
 	ss, ok := b.(*ScalarImpl)
 	if !ok {
@@ -453,6 +686,30 @@ func (s *ScalarImpl) Mul(b Scalar) Scalar {
 	}
 }
 
+// InverseConstantTime inverts the scalar by exponentiation on dcrd's
+// constant time ModNScalar, since big.Int operations aren't constant time.
+// Converting to and from big.Int only depends on the length of the value.
+func (s *ScalarImpl) InverseConstantTime() (Scalar, error) {
+	var b [32]byte
+	s.value.FillBytes(b[:])
+	defer clear(b[:])
+
+	var inner dcrsecp256k1.ModNScalar
+	inner.SetBytes(&b)
+	defer inner.Zero()
+	if inner.IsZero() {
+		return nil, types.ErrZeroInverse
+	}
+
+	inv := invertConstantTime(&inner)
+	defer inv.Zero()
+	inv.PutBytes(&b)
+
+	return &ScalarImpl{
+		value: new(big.Int).SetBytes(b[:]),
+	}, nil
+}
+
 func (s *ScalarImpl) Inverse() Scalar {
 	curve := ethsecp256k1.S256()
 	result := getBigInt()
@@ -467,12 +724,40 @@ func (s *ScalarImpl) Inverse() Scalar {
 	}
 }
 
+func (s *ScalarImpl) Div(b Scalar) (Scalar, error) {
+	ss, ok := b.(*ScalarImpl)
+	if !ok {
+		panic("invalid scalar; type is not *secp256k1.ScalarImpl")
+	}
+
+	curve := ethsecp256k1.S256()
+	result := getBigInt()
+	if result.ModInverse(ss.value, curve.Params().N) == nil {
+		putBigInt(result)
+		return nil, types.ErrZeroInverse
+	}
+
+	result.Mul(result, s.value)
+	result.Mod(result, curve.Params().N)
+	return &ScalarImpl{
+		value: result,
+	}, nil
+}
+
 func (s *ScalarImpl) Encode() []byte {
 	b := make([]byte, 32)
 	s.value.FillBytes(b)
 	return b
 }
 
+// Bytes is like Encode, but returns the big-endian encoding as an array, so
+// it doesn't need to be allocated on the heap.
+func (s *ScalarImpl) Bytes() [32]byte {
+	var b [32]byte
+	s.value.FillBytes(b[:])
+	return b
+}
+
 func (s *ScalarImpl) Eq(other Scalar) bool {
 	o, ok := other.(*ScalarImpl)
 	if !ok {
@@ -486,6 +771,45 @@ func (s *ScalarImpl) IsZero() bool {
 	return s.value.Sign() == 0
 }
 
+// Normalize reduces the value modulo the curve order, since ScalarFromBytes
+// doesn't.
+func (s *ScalarImpl) Normalize() Scalar {
+	return &ScalarImpl{
+		value: new(big.Int).Mod(s.value, ethsecp256k1.S256().Params().N),
+	}
+}
+
+// Zeroize clears the words backing the value before resetting it, as
+// big.Int keeps its old backing array around.
+func (s *ScalarImpl) Zeroize() {
+	words := s.value.Bits()
+	for i := range words {
+		words[i] = 0
+	}
+	s.value.SetInt64(0)
+}
+
+func (s *ScalarImpl) SetUint64(v uint64) Scalar {
+	if s.value == nil {
+		s.value = new(big.Int)
+	}
+	s.value.SetUint64(v)
+	return s
+}
+
+// SetBytes sets the scalar to the big-endian b, reduced modulo n.
+func (s *ScalarImpl) SetBytes(b []byte) error {
+	if len(b) != 32 {
+		return errors.New("invalid scalar length")
+	}
+
+	if s.value == nil {
+		s.value = new(big.Int)
+	}
+	s.value.SetBytes(b).Mod(s.value, ethsecp256k1.S256().Params().N)
+	return nil
+}
+
 type PointImpl struct {
 	x, y *big.Int
 }
@@ -527,12 +851,14 @@ func (p *PointImpl) Add(b Point) Point {
 		ppy = big.NewInt(0)
 	}
 
+	// Add returns the other operand's coordinates if either is the point at
+	// infinity, copy them so the result doesn't alias an input
 	curve := ethsecp256k1.S256()
 	x, y := curve.Add(px, py, ppx, ppy)
 
 	return &PointImpl{
-		x: x,
-		y: y,
+		x: new(big.Int).Set(x),
+		y: new(big.Int).Set(y),
 	}
 }
 
@@ -559,6 +885,15 @@ func (p *PointImpl) Sub(b Point) Point {
 		ppy = big.NewInt(0)
 	}
 
+	// subtracting the point at infinity leaves p unchanged, and its y
+	// coordinate can't be negated below
+	if ppx.Sign() == 0 && ppy.Sign() == 0 {
+		return &PointImpl{
+			x: new(big.Int).Set(px),
+			y: new(big.Int).Set(py),
+		}
+	}
+
 	// Negate the point and add
 	curve := ethsecp256k1.S256()
 	negY := new(big.Int).Sub(curve.Params().P, ppy)
@@ -585,12 +920,18 @@ func (p *PointImpl) ScalarMul(s Scalar) Point {
 		py = big.NewInt(0)
 	}
 
+	if px.Sign() == 0 && py.Sign() == 0 {
+		return &PointImpl{
+			x: big.NewInt(0),
+			y: big.NewInt(0),
+		}
+	}
+
 	// Convert scalar to bytes
 	scalarBytes := make([]byte, 32)
 	ss.value.FillBytes(scalarBytes)
 
-	// Use Ethereum's scalar multiplication
-	x, y := ethsecp256k1.S256().ScalarMult(px, py, scalarBytes)
+	x, y := nativeScalarMul(px, py, scalarBytes)
 
 	return &PointImpl{
 		x: x,
@@ -619,6 +960,9 @@ func (p *PointImpl) Encode() []byte {
 	return compressed
 }
 
+// Normalize is a no-op, points are always stored in affine coordinates.
+func (*PointImpl) Normalize() {}
+
 func (p *PointImpl) IsZero() bool {
 	// Handle nil coordinates
 	px, py := p.x, p.y
@@ -631,6 +975,46 @@ func (p *PointImpl) IsZero() bool {
 	return px.Sign() == 0 && py.Sign() == 0
 }
 
+var _ types.PointEncodeInto = &PointImpl{}
+
+func (p *PointImpl) EncodeInto(dst []byte) (int, error) {
+	if len(dst) < 33 {
+		return 0, types.ErrBufferTooSmall
+	}
+
+	dst[0] = 0x02
+	if p.y != nil && p.y.Bit(0) == 1 {
+		dst[0] = 0x03
+	}
+
+	if p.x != nil {
+		p.x.FillBytes(dst[1:33])
+	} else {
+		clear(dst[1:33])
+	}
+	return 33, nil
+}
+
+func (p *PointImpl) EqualsBytes(enc []byte) bool {
+	if len(enc) != 33 {
+		return false
+	}
+
+	prefix := byte(0x02)
+	if p.y != nil && p.y.Bit(0) == 1 {
+		prefix = 0x03
+	}
+	if enc[0] != prefix {
+		return false
+	}
+
+	var x [32]byte
+	if p.x != nil {
+		p.x.FillBytes(x[:])
+	}
+	return bytes.Equal(x[:], enc[1:])
+}
+
 func (p *PointImpl) Equals(other Point) bool {
 	pp, ok := other.(*PointImpl)
 	if !ok {
@@ -0,0 +1,147 @@
+package secp256k1
+
+import (
+	"errors"
+	"fmt"
+
+	dcrsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// CompactToDER encodes a 64-byte r || s signature, as returned by
+// SignCompact, as DER. It doesn't go through big.Int; r and s are minimally
+// encoded as positive INTEGERs, so the output is strict DER as required by
+// eg. Bitcoin consensus rules.
+func CompactToDER(sig [64]byte) []byte {
+	r := derInteger(sig[:32])
+	s := derInteger(sig[32:])
+
+	der := make([]byte, 0, 6+len(r)+len(s))
+	der = append(der, 0x30, byte(4+len(r)+len(s))) // SEQUENCE header
+	der = append(der, 0x02, byte(len(r)))          // INTEGER header for r
+	der = append(der, r...)
+	der = append(der, 0x02, byte(len(s))) // INTEGER header for s
+	der = append(der, s...)
+	return der
+}
+
+// derInteger returns the minimal big-endian two's complement content bytes
+// of the unsigned integer b, without copying when possible.
+func derInteger(b []byte) []byte {
+	for len(b) > 1 && b[0] == 0 {
+		b = b[1:]
+	}
+
+	// add a leading zero if the high bit is set, so it isn't negative
+	if b[0]&0x80 != 0 {
+		return append([]byte{0}, b...)
+	}
+
+	return b
+}
+
+// DERToCompact decodes a strict DER signature into its 64-byte r || s form.
+// Encodings that aren't minimal, such as integers with unnecessary leading
+// zeros, negative integers or trailing data, are rejected, so every
+// signature has exactly one accepted encoding.
+func DERToCompact(der []byte) ([64]byte, error) {
+	var sig [64]byte
+
+	// 0x30 len 0x02 rlen r 0x02 slen s, with r and s of 1 to 33 bytes
+	if len(der) < 8 || len(der) > 72 {
+		return sig, errors.New("invalid DER signature length")
+	}
+
+	if der[0] != 0x30 {
+		return sig, errors.New("invalid DER signature; not a sequence")
+	}
+
+	if int(der[1]) != len(der)-2 {
+		return sig, errors.New("invalid DER signature; wrong sequence length")
+	}
+
+	rest, err := readDERInteger(der[2:], sig[:32])
+	if err != nil {
+		return sig, fmt.Errorf("invalid r: %w", err)
+	}
+
+	rest, err = readDERInteger(rest, sig[32:])
+	if err != nil {
+		return sig, fmt.Errorf("invalid s: %w", err)
+	}
+
+	if len(rest) != 0 {
+		return sig, errors.New("invalid DER signature; trailing data")
+	}
+
+	return sig, nil
+}
+
+// readDERInteger reads a minimally encoded positive INTEGER of at most 32
+// bytes from the start of in into the big-endian dst, and returns the bytes
+// after it.
+func readDERInteger(in []byte, dst []byte) ([]byte, error) {
+	if len(in) < 2 || in[0] != 0x02 {
+		return nil, errors.New("not an integer")
+	}
+
+	n := int(in[1])
+	in = in[2:]
+	if n == 0 || n > len(in) {
+		return nil, errors.New("invalid integer length")
+	}
+
+	b := in[:n]
+	if b[0]&0x80 != 0 {
+		return nil, errors.New("integer is negative")
+	}
+
+	if len(b) > 1 && b[0] == 0 && b[1]&0x80 == 0 {
+		return nil, errors.New("integer is not minimally encoded")
+	}
+
+	if b[0] == 0 {
+		b = b[1:]
+	}
+
+	if len(b) > len(dst) {
+		return nil, errors.New("integer is too large")
+	}
+
+	copy(dst[len(dst)-len(b):], b)
+	return in[n:], nil
+}
+
+// SignatureDERToEth converts a strict DER signature, as made by Sign, to the
+// 64-byte r || s form taken by go-ethereum's VerifySignature. libsecp256k1
+// only accepts signatures with s at most half the group order, so a high s
+// is replaced by its negation, which is an equally valid signature. r and s
+// must be non-zero and below the group order.
+func SignatureDERToEth(der []byte) ([64]byte, error) {
+	sig, err := DERToCompact(der)
+	if err != nil {
+		return sig, err
+	}
+
+	var r, s dcrsecp256k1.ModNScalar
+	if r.SetByteSlice(sig[:32]) || r.IsZero() {
+		return [64]byte{}, errors.New("invalid r; not in [1, n)")
+	}
+
+	if s.SetByteSlice(sig[32:]) || s.IsZero() {
+		return [64]byte{}, errors.New("invalid s; not in [1, n)")
+	}
+
+	if s.IsOverHalfOrder() {
+		s.Negate()
+		s.PutBytesUnchecked(sig[32:])
+	}
+
+	return sig, nil
+}
+
+// SignatureEthToDER converts a 64-byte r || s signature, as taken by
+// go-ethereum's VerifySignature, to strict DER. It is CompactToDER; the
+// 64-byte forms are the same.
+func SignatureEthToDER(sig [64]byte) []byte {
+	return CompactToDER(sig)
+}
@@ -0,0 +1,165 @@
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	dcrsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactToDER(t *testing.T) {
+	var sig [64]byte
+	sig[31] = 0x01 // r = 1
+	sig[32] = 0x80 // s has its high bit set
+	sig[63] = 0x02
+
+	der := CompactToDER(sig)
+	require.Equal(t, "3026"+"020101"+"022100"+"8000000000000000000000000000000000000000000000000000000000000002", hex.EncodeToString(der))
+
+	res, err := DERToCompact(der)
+	require.NoError(t, err)
+	require.Equal(t, sig, res)
+}
+
+func TestDERToCompact(t *testing.T) {
+	r := "0100000000000000000000000000000000000000000000000000000000000001"
+	s := "7f00000000000000000000000000000000000000000000000000000000000002"
+	high := "8000000000000000000000000000000000000000000000000000000000000003"
+
+	cases := []struct {
+		name  string
+		der   string
+		valid bool
+	}{
+		{"minimal", "3044" + "0220" + r + "0220" + s, true},
+		{"minimal with sign byte", "3045" + "0220" + r + "022100" + high, true},
+		{"minimal short", "3006" + "020101" + "020102", true},
+		{"extra leading zero", "3045" + "022100" + r + "0220" + s, false},
+		{"extra leading zero before sign byte", "3046" + "0220" + r + "02220000" + high, false},
+		{"zero padded short", "3007" + "02020001" + "020102", false},
+		{"negative", "3044" + "0220" + r + "0220" + high, false},
+		{"too large", "3045" + "022101" + r + "0220" + s, false},
+		{"empty integer", "3025" + "0200" + "0220" + s, false},
+		{"wrong sequence length", "3045" + "0220" + r + "0220" + s, false},
+		{"trailing data", "3046" + "0220" + r + "0220" + s + "0000", false},
+		{"not a sequence", "3144" + "0220" + r + "0220" + s, false},
+		{"not an integer", "3044" + "0320" + r + "0220" + s, false},
+		{"truncated", "3044" + "0220" + r + "0220", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			der, err := hex.DecodeString(c.der)
+			require.NoError(t, err)
+
+			sig, err := DERToCompact(der)
+			if !c.valid {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, der, CompactToDER(sig))
+		})
+	}
+}
+
+func TestVerify_RejectsNonMinimalDER(t *testing.T) {
+	curve := NewCurve()
+	privKey := curve.NewRandomScalar()
+	pubKey := curve.ScalarBaseMul(privKey)
+	msgPoint := curve.BasePoint()
+
+	sig, err := curve.Sign(privKey, msgPoint)
+	require.NoError(t, err)
+	require.True(t, curve.Verify(pubKey, msgPoint, sig))
+
+	// pad r with a zero byte; the value is unchanged but the encoding
+	// is no longer minimal
+	rLen := int(sig[3])
+	padded := []byte{0x30, sig[1] + 1, 0x02, byte(rLen + 1), 0x00}
+	padded = append(padded, sig[4:]...)
+	require.False(t, curve.Verify(pubKey, msgPoint, padded))
+}
+
+func TestSignatureDERToEth(t *testing.T) {
+	curve := NewCurve()
+	privKey := curve.NewRandomScalar()
+	pubKey := curve.ScalarBaseMul(privKey)
+	msgPoint := curve.BasePoint()
+
+	der, err := curve.Sign(privKey, msgPoint)
+	require.NoError(t, err)
+
+	sig, err := SignatureDERToEth(der)
+	require.NoError(t, err)
+	require.Equal(t, der, SignatureEthToDER(sig))
+	require.True(t, curve.(*CurveImpl).VerifyCompact(pubKey, msgPoint, sig))
+
+	// a high s is negated
+	var s dcrsecp256k1.ModNScalar
+	s.SetByteSlice(sig[32:])
+	require.False(t, s.IsOverHalfOrder())
+	high := sig
+	s.Negate().PutBytesUnchecked(high[32:])
+
+	res, err := SignatureDERToEth(CompactToDER(high))
+	require.NoError(t, err)
+	require.Equal(t, sig, res)
+
+	// zero and out of range r and s are rejected
+	var n [32]byte
+	dcrsecp256k1.Params().N.FillBytes(n[:])
+	for _, field := range []int{0, 32} {
+		for _, v := range [][32]byte{{}, n} {
+			bad := sig
+			copy(bad[field:field+32], v[:])
+			_, err = SignatureDERToEth(CompactToDER(bad))
+			require.Error(t, err)
+		}
+	}
+
+	_, err = SignatureDERToEth(der[:len(der)-1])
+	require.Error(t, err)
+}
+
+// TestVerify_RejectsZeroRS checks that signatures with r or s of zero, or of
+// the group order, are invalid, as they are for go-ethereum.
+func TestVerify_RejectsZeroRS(t *testing.T) {
+	curve := NewCurve().(*CurveImpl)
+	privKey := curve.NewRandomScalar()
+	pubKey := curve.ScalarBaseMul(privKey)
+	msgPoint := curve.BasePoint()
+	hash := sha256.Sum256(msgPoint.Encode())
+
+	der, err := curve.Sign(privKey, msgPoint)
+	require.NoError(t, err)
+	sig, err := DERToCompact(der)
+	require.NoError(t, err)
+	require.True(t, curve.Verify(pubKey, msgPoint, der))
+	require.True(t, crypto.VerifySignature(pubKey.Encode(), hash[:], sig[:]))
+
+	var n [32]byte
+	dcrsecp256k1.Params().N.FillBytes(n[:])
+	for _, field := range []int{0, 32} {
+		for _, v := range [][32]byte{{}, n} {
+			bad := sig
+			copy(bad[field:field+32], v[:])
+
+			// zero encodes as the minimal DER integer 0x00
+			badDER := CompactToDER(bad)
+			_, err := DERToCompact(badDER)
+			require.NoError(t, err)
+
+			require.False(t, curve.Verify(pubKey, msgPoint, badDER))
+			require.False(t, curve.VerifyHash(pubKey, hash, badDER))
+			require.False(t, curve.VerifyCompact(pubKey, msgPoint, bad))
+			require.False(t, crypto.VerifySignature(pubKey.Encode(), hash[:], bad[:]))
+		}
+	}
+
+	require.Equal(t, "3006020100020101", hex.EncodeToString(CompactToDER([64]byte{63: 1})))
+}
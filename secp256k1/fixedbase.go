@@ -0,0 +1,125 @@
+package secp256k1
+
+import (
+	"math/big"
+
+	dcrsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// fixedBaseTable holds multiples of a point for windowed multiplication:
+// entry j of window i is (j+1) * 256^i * P, one window per byte of the
+// scalar. The entries are affine, so that adding them takes dcrd's cheaper
+// mixed addition. A table is about 1 MiB.
+type fixedBaseTable [32][255]dcrsecp256k1.JacobianPoint
+
+func newFixedBaseTable(p *dcrsecp256k1.JacobianPoint) *fixedBaseTable {
+	table := new(fixedBaseTable)
+
+	var base dcrsecp256k1.JacobianPoint
+	base.Set(p)
+	for i := range table {
+		window := &table[i]
+		window[0].Set(&base)
+		for j := 1; j < len(window); j++ {
+			dcrsecp256k1.AddNonConst(&window[j-1], &base, &window[j])
+		}
+
+		// the next window's base is 256 times this one's
+		dcrsecp256k1.AddNonConst(&window[len(window)-1], &base, &base)
+		batchToAffine(window[:])
+	}
+
+	return table
+}
+
+// mul sets result to k*P, in Jacobian coordinates, for a 32-byte big-endian
+// k, which needn't be reduced. Like ScalarMultNonConst it isn't constant
+// time.
+func (t *fixedBaseTable) mul(k *[32]byte, result *dcrsecp256k1.JacobianPoint) {
+	// the zero point, with Z = 0, is the point at infinity
+	*result = dcrsecp256k1.JacobianPoint{}
+	for i := range t {
+		d := k[31-i]
+		if d != 0 {
+			dcrsecp256k1.AddNonConst(result, &t[i][d-1], result)
+		}
+	}
+}
+
+// batchToAffine converts the points to affine coordinates with a single
+// inversion, by Montgomery's trick.
+func batchToAffine(points []dcrsecp256k1.JacobianPoint) {
+	for i := range points {
+		if points[i].Z.IsZero() {
+			// the trick needs every Z to be invertible
+			for j := range points {
+				toAffine(&points[j])
+			}
+			return
+		}
+	}
+
+	// prefix[i] is the product of the Z coordinates of points[:i+1]
+	prefix := make([]dcrsecp256k1.FieldVal, len(points))
+	prefix[0].Set(&points[0].Z)
+	for i := 1; i < len(points); i++ {
+		prefix[i].Mul2(&prefix[i-1], &points[i].Z)
+	}
+
+	// inv is the inverse of prefix[i] at step i
+	var inv dcrsecp256k1.FieldVal
+	product := prefix[len(prefix)-1]
+	product.Normalize()
+	p := dcrsecp256k1.S256().P
+	inv.SetByteSlice(fieldToBig(&product).ModInverse(fieldToBig(&product), p).Bytes())
+
+	for i := len(points) - 1; i >= 0; i-- {
+		point := &points[i]
+
+		var zInv, zInv2 dcrsecp256k1.FieldVal
+		if i > 0 {
+			zInv.Mul2(&inv, &prefix[i-1])
+			inv.Mul(&point.Z)
+		} else {
+			zInv.Set(&inv)
+		}
+
+		zInv2.SquareVal(&zInv)
+		point.X.Mul(&zInv2).Normalize()
+		point.Y.Mul(zInv2.Mul(&zInv)).Normalize()
+		point.Z.SetInt(1)
+	}
+}
+
+// affineBig returns the affine coordinates of p, or (0, 0) for the point at
+// infinity. big.Int's inversion is several times faster than dcrd's
+// ToAffine, which exponentiates.
+func affineBig(point *dcrsecp256k1.JacobianPoint) (*big.Int, *big.Int) {
+	if point.Z.IsZero() || (point.X.IsZero() && point.Y.IsZero()) {
+		return new(big.Int), new(big.Int)
+	}
+
+	p := dcrsecp256k1.S256().P
+	x, y, z := fieldToBig(&point.X), fieldToBig(&point.Y), fieldToBig(&point.Z)
+	zInv := z.ModInverse(z, p)
+	zInv2 := new(big.Int).Mul(zInv, zInv)
+	zInv2.Mod(zInv2, p)
+
+	x.Mul(x, zInv2).Mod(x, p)
+	y.Mul(y, zInv2).Mod(y, p)
+	y.Mul(y, zInv).Mod(y, p)
+	return x, y
+}
+
+// toAffine is point.ToAffine, using affineBig.
+func toAffine(point *dcrsecp256k1.JacobianPoint) {
+	x, y := affineBig(point)
+	point.X.SetByteSlice(x.Bytes())
+	point.Y.SetByteSlice(y.Bytes())
+	point.Z.SetInt(1)
+}
+
+func fieldToBig(f *dcrsecp256k1.FieldVal) *big.Int {
+	b := f.Bytes()
+	return new(big.Int).SetBytes(b[:])
+}
@@ -0,0 +1,32 @@
+package secp256k1
+
+import (
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// orderMinusTwo is n-2, the exponent of the Fermat inverse modulo the curve
+// order n.
+var orderMinusTwo = [32]byte{
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe,
+	0xba, 0xae, 0xdc, 0xe6, 0xaf, 0x48, 0xa0, 0x3b,
+	0xbf, 0xd2, 0x5e, 0x8c, 0xd0, 0x36, 0x41, 0x3f,
+}
+
+// invertConstantTime returns s^(n-2) = s^-1 modulo the curve order by
+// square-and-multiply. The sequence of operations only depends on the
+// public exponent, and ModNScalar arithmetic is constant time, so the
+// inversion doesn't leak s through timing. s must not be zero.
+func invertConstantTime(s *secp256k1.ModNScalar) *secp256k1.ModNScalar {
+	r := new(secp256k1.ModNScalar).SetInt(1)
+	for _, b := range orderMinusTwo {
+		for i := 7; i >= 0; i-- {
+			r.Square()
+			if b>>i&1 == 1 {
+				r.Mul(s)
+			}
+		}
+	}
+
+	return r
+}
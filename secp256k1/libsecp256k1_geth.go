@@ -0,0 +1,51 @@
+//go:build cgo && ethereum_secp256k1 && !system_libsecp256k1
+// +build cgo,ethereum_secp256k1,!system_libsecp256k1
+
+package secp256k1
+
+import (
+	"math/big"
+
+	ethsecp256k1 "github.com/ethereum/go-ethereum/crypto/secp256k1"
+)
+
+// nativeLibrary describes which libsecp256k1 the Ethereum backend calls into.
+const nativeLibrary = "go-ethereum bundled libsecp256k1"
+
+// nativeScalarBaseMul returns k*G for a 32-byte big-endian k, or nil
+// coordinates if k is zero or not below the group order.
+func nativeScalarBaseMul(k []byte) (*big.Int, *big.Int) {
+	return ethsecp256k1.S256().ScalarBaseMult(k)
+}
+
+// nativeScalarBaseMulVartime is nativeScalarBaseMul for public scalars.
+// go-ethereum's ScalarBaseMult is a generic multiplication by G that doesn't
+// use libsecp256k1's precomputed tables, so the faster, variable time
+// fixed-base comb is used instead.
+func nativeScalarBaseMulVartime(k []byte) (*big.Int, *big.Int) {
+	return combScalarBaseMul(k)
+}
+
+// nativeScalarMul returns k*(x, y) for a 32-byte big-endian k, or nil
+// coordinates if k is zero or not below the group order.
+func nativeScalarMul(x, y *big.Int, k []byte) (*big.Int, *big.Int) {
+	return ethsecp256k1.S256().ScalarMult(x, y, k)
+}
+
+// nativeSign returns the 64-byte r || s ECDSA signature of hash, using an
+// RFC6979 nonce and a low s.
+func nativeSign(hash, seckey []byte) ([]byte, error) {
+	sig, err := ethsecp256k1.Sign(hash, seckey)
+	if err != nil {
+		return nil, err
+	}
+
+	// drop the recovery id
+	return sig[:64], nil
+}
+
+// nativeVerify verifies a 64-byte r || s signature of hash against a 65-byte
+// uncompressed public key.
+func nativeVerify(pubkey, hash, sig []byte) bool {
+	return ethsecp256k1.VerifySignature(pubkey, hash, sig)
+}
@@ -0,0 +1,155 @@
+//go:build cgo && ethereum_secp256k1 && system_libsecp256k1
+// +build cgo,ethereum_secp256k1,system_libsecp256k1
+
+package secp256k1
+
+/*
+#cgo LDFLAGS: -lsecp256k1
+
+#include <secp256k1.h>
+*/
+import "C"
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"unsafe"
+)
+
+// nativeLibrary describes which libsecp256k1 the Ethereum backend calls into.
+const nativeLibrary = "system libsecp256k1"
+
+// systemContext is shared by all calls. libsecp256k1 contexts are safe to use
+// concurrently once created and randomized.
+var systemContext = newSystemContext()
+
+func newSystemContext() *C.secp256k1_context {
+	ctx := C.secp256k1_context_create(C.SECP256K1_CONTEXT_SIGN | C.SECP256K1_CONTEXT_VERIFY)
+	if ctx == nil {
+		panic("failed to create libsecp256k1 context")
+	}
+
+	// blinding for side-channel protection of signing and base multiplication
+	var seed [32]byte
+	_, err := rand.Read(seed[:])
+	if err != nil {
+		panic(err)
+	}
+
+	if C.secp256k1_context_randomize(ctx, (*C.uchar)(unsafe.Pointer(&seed[0]))) != 1 {
+		panic("failed to randomize libsecp256k1 context")
+	}
+
+	return ctx
+}
+
+// nativeScalarBaseMul returns k*G for a 32-byte big-endian k, or nil
+// coordinates if k is zero or not below the group order.
+func nativeScalarBaseMul(k []byte) (*big.Int, *big.Int) {
+	if len(k) != 32 {
+		return nil, nil
+	}
+
+	var pub C.secp256k1_pubkey
+	if C.secp256k1_ec_pubkey_create(systemContext, &pub, (*C.uchar)(unsafe.Pointer(&k[0]))) != 1 {
+		return nil, nil
+	}
+
+	return serializePubkey(&pub)
+}
+
+// nativeScalarBaseMulVartime is nativeScalarBaseMul for public scalars.
+// libsecp256k1's base multiplication already uses precomputed tables, so
+// there's nothing to gain from a variable time one.
+func nativeScalarBaseMulVartime(k []byte) (*big.Int, *big.Int) {
+	return nativeScalarBaseMul(k)
+}
+
+// nativeScalarMul returns k*(x, y) for a 32-byte big-endian k, or nil
+// coordinates if k is zero or not below the group order, or if (x, y) is not
+// a point on the curve.
+func nativeScalarMul(x, y *big.Int, k []byte) (*big.Int, *big.Int) {
+	if len(k) != 32 || x == nil || y == nil {
+		return nil, nil
+	}
+
+	var enc [65]byte
+	enc[0] = 0x04
+	x.FillBytes(enc[1:33])
+	y.FillBytes(enc[33:])
+
+	var pub C.secp256k1_pubkey
+	if C.secp256k1_ec_pubkey_parse(systemContext, &pub, (*C.uchar)(unsafe.Pointer(&enc[0])), 65) != 1 {
+		return nil, nil
+	}
+
+	if C.secp256k1_ec_pubkey_tweak_mul(systemContext, &pub, (*C.uchar)(unsafe.Pointer(&k[0]))) != 1 {
+		return nil, nil
+	}
+
+	return serializePubkey(&pub)
+}
+
+// nativeSign returns the 64-byte r || s ECDSA signature of hash, using an
+// RFC6979 nonce and a low s.
+func nativeSign(hash, seckey []byte) ([]byte, error) {
+	if len(hash) != 32 {
+		return nil, errors.New("hash must be 32 bytes")
+	}
+
+	if len(seckey) != 32 {
+		return nil, errors.New("private key must be 32 bytes")
+	}
+
+	var sig C.secp256k1_ecdsa_signature
+	if C.secp256k1_ecdsa_sign(
+		systemContext,
+		&sig,
+		(*C.uchar)(unsafe.Pointer(&hash[0])),
+		(*C.uchar)(unsafe.Pointer(&seckey[0])),
+		nil, // RFC6979
+		nil,
+	) != 1 {
+		return nil, errors.New("failed to sign")
+	}
+
+	out := make([]byte, 64)
+	C.secp256k1_ecdsa_signature_serialize_compact(systemContext, (*C.uchar)(unsafe.Pointer(&out[0])), &sig)
+	return out, nil
+}
+
+// nativeVerify verifies a 64-byte r || s signature of hash against a 65-byte
+// uncompressed public key. Like go-ethereum, signatures with a high s are
+// rejected.
+func nativeVerify(pubkey, hash, sig []byte) bool {
+	if len(pubkey) != 65 || len(hash) != 32 || len(sig) != 64 {
+		return false
+	}
+
+	var pub C.secp256k1_pubkey
+	if C.secp256k1_ec_pubkey_parse(systemContext, &pub, (*C.uchar)(unsafe.Pointer(&pubkey[0])), 65) != 1 {
+		return false
+	}
+
+	var s C.secp256k1_ecdsa_signature
+	if C.secp256k1_ecdsa_signature_parse_compact(systemContext, &s, (*C.uchar)(unsafe.Pointer(&sig[0]))) != 1 {
+		return false
+	}
+
+	return C.secp256k1_ecdsa_verify(systemContext, &s, (*C.uchar)(unsafe.Pointer(&hash[0])), &pub) == 1
+}
+
+func serializePubkey(pub *C.secp256k1_pubkey) (*big.Int, *big.Int) {
+	var out [65]byte
+	outLen := C.size_t(len(out))
+	C.secp256k1_ec_pubkey_serialize(
+		systemContext,
+		(*C.uchar)(unsafe.Pointer(&out[0])),
+		&outLen,
+		pub,
+		C.SECP256K1_EC_UNCOMPRESSED,
+	)
+
+	return new(big.Int).SetBytes(out[1:33]), new(big.Int).SetBytes(out[33:])
+}
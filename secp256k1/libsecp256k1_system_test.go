@@ -0,0 +1,130 @@
+//go:build cgo && ethereum_secp256k1 && system_libsecp256k1
+// +build cgo,ethereum_secp256k1,system_libsecp256k1
+
+package secp256k1
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	ethsecp256k1 "github.com/ethereum/go-ethereum/crypto/secp256k1"
+)
+
+// TestSystemLibsecp256k1Compatibility checks that the system libsecp256k1
+// bindings give the same results as the go-ethereum wrapper.
+func TestSystemLibsecp256k1Compatibility(t *testing.T) {
+	curve := ethsecp256k1.S256()
+
+	for i := 0; i < 32; i++ {
+		var k [32]byte
+		_, err := rand.Read(k[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		k[0] &= 0x7f // below the group order
+
+		x, y := nativeScalarBaseMul(k[:])
+		ex, ey := curve.ScalarBaseMult(append([]byte{}, k[:]...))
+		if x.Cmp(ex) != 0 || y.Cmp(ey) != 0 {
+			t.Fatalf("ScalarBaseMul mismatch for %x", k)
+		}
+
+		mx, my := nativeScalarMul(x, y, k[:])
+		emx, emy := curve.ScalarMult(ex, ey, append([]byte{}, k[:]...))
+		if mx.Cmp(emx) != 0 || my.Cmp(emy) != 0 {
+			t.Fatalf("ScalarMul mismatch for %x", k)
+		}
+
+		hash := sha256.Sum256(k[:])
+		sig, err := nativeSign(hash[:], k[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ethSig, err := ethsecp256k1.Sign(hash[:], k[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(sig, ethSig[:64]) {
+			t.Fatalf("Sign mismatch for %x", k)
+		}
+
+		pub := make([]byte, 65)
+		pub[0] = 0x04
+		x.FillBytes(pub[1:33])
+		y.FillBytes(pub[33:])
+		if !nativeVerify(pub, hash[:], sig) || !ethsecp256k1.VerifySignature(pub, hash[:], sig) {
+			t.Fatalf("Verify failed for %x", k)
+		}
+
+		hash[0] ^= 1
+		if nativeVerify(pub, hash[:], sig) {
+			t.Fatalf("Verify accepted a signature over the wrong hash")
+		}
+	}
+
+	// zero and out of range scalars give no point, like go-ethereum
+	var zero [32]byte
+	if x, _ := nativeScalarBaseMul(zero[:]); x != nil {
+		t.Fatal("expected no point for a zero scalar")
+	}
+
+	order := bytes.Repeat([]byte{0xff}, 32)
+	if x, _ := nativeScalarBaseMul(order); x != nil {
+		t.Fatal("expected no point for a scalar above the order")
+	}
+}
+
+func BenchmarkScalarBaseMul_System(b *testing.B) {
+	var k [32]byte
+	_, _ = rand.Read(k[:])
+	k[0] &= 0x7f
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = nativeScalarBaseMul(k[:])
+	}
+}
+
+func BenchmarkScalarBaseMul_GoEthereum(b *testing.B) {
+	var k [32]byte
+	_, _ = rand.Read(k[:])
+	k[0] &= 0x7f
+	buf := make([]byte, 32)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// go-ethereum clears the scalar after use
+		copy(buf, k[:])
+		_, _ = ethsecp256k1.S256().ScalarBaseMult(buf)
+	}
+}
+
+func BenchmarkScalarMul_System(b *testing.B) {
+	var k [32]byte
+	_, _ = rand.Read(k[:])
+	k[0] &= 0x7f
+	x, y := nativeScalarBaseMul(k[:])
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = nativeScalarMul(x, y, k[:])
+	}
+}
+
+func BenchmarkScalarMul_GoEthereum(b *testing.B) {
+	var k [32]byte
+	_, _ = rand.Read(k[:])
+	k[0] &= 0x7f
+	x, y := nativeScalarBaseMul(k[:])
+	buf := make([]byte, 32)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(buf, k[:])
+		_, _ = ethsecp256k1.S256().ScalarMult(x, y, buf)
+	}
+}
@@ -0,0 +1,36 @@
+package secp256k1
+
+import (
+	"bytes"
+	"testing"
+
+	dcrsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCurve_NewRandomScalar_Resamples checks that randomness of the group
+// order or more, or zero, is read again rather than reduced.
+func TestCurve_NewRandomScalar_Resamples(t *testing.T) {
+	curve := NewCurve().(*CurveImpl)
+
+	var n, ones, zero, valid [32]byte
+	dcrsecp256k1.Params().N.FillBytes(n[:])
+	for i := range ones {
+		ones[i] = 0xff
+	}
+	valid[31] = 42
+
+	var r bytes.Buffer
+	for _, b := range [][32]byte{n, ones, zero, valid} {
+		r.Write(b[:])
+	}
+
+	s := curve.newRandomScalar(&r)
+	require.Equal(t, valid[:], s.Encode())
+	require.Zero(t, r.Len())
+
+	// a reader that never yields a valid scalar fails rather than loops
+	r.Reset()
+	r.Write(n[:])
+	require.Panics(t, func() { curve.newRandomScalar(&r) })
+}
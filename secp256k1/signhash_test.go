@@ -0,0 +1,53 @@
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurve_SignHash(t *testing.T) {
+	curve := NewCurve().(*CurveImpl)
+	privKey := curve.NewRandomScalar()
+	pubKey := curve.ScalarBaseMul(privKey)
+
+	// a known hash, as if computed elsewhere with another hash function
+	hashBytes, err := hex.DecodeString("c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470")
+	require.NoError(t, err)
+	hash := [32]byte(hashBytes)
+
+	sig, err := curve.SignHash(privKey, hash)
+	require.NoError(t, err)
+	require.True(t, curve.VerifyHash(pubKey, hash, sig))
+
+	wrongHash := hash
+	wrongHash[0] ^= 1
+	require.False(t, curve.VerifyHash(pubKey, wrongHash, sig))
+	require.False(t, curve.VerifyHash(curve.BasePoint(), hash, sig))
+	require.False(t, curve.VerifyHash(pubKey, hash, sig[:len(sig)-1]))
+
+	// Sign is SignHash of the SHA-256 digest of the point
+	msgPoint := curve.ScalarBaseMul(curve.NewRandomScalar())
+	signed, err := curve.Sign(privKey, msgPoint)
+	require.NoError(t, err)
+	hashed, err := curve.SignHash(privKey, sha256.Sum256(msgPoint.Encode()))
+	require.NoError(t, err)
+	require.Equal(t, signed, hashed)
+	require.True(t, curve.VerifyHash(pubKey, sha256.Sum256(msgPoint.Encode()), signed))
+
+	// go-ethereum's Sign derives the nonce with RFC6979 too, so it gives
+	// the same signature, plus a recovery id
+	ethKey, err := crypto.ToECDSA(privKey.Encode())
+	require.NoError(t, err)
+	ethSig, err := crypto.Sign(hash[:], ethKey)
+	require.NoError(t, err)
+	require.Equal(t, sig, CompactToDER([64]byte(ethSig[:64])))
+	require.True(t, curve.VerifyHash(pubKey, hash, CompactToDER([64]byte(ethSig[:64]))))
+
+	compact, err := DERToCompact(sig)
+	require.NoError(t, err)
+	require.True(t, crypto.VerifySignature(pubKey.Encode(), hash[:], compact[:]))
+}
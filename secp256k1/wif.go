@@ -0,0 +1,76 @@
+package secp256k1
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// WIF version bytes of Bitcoin mainnet and testnet private keys.
+const (
+	wifMainnet = 0x80
+	wifTestnet = 0xef
+)
+
+// ImportWIF decodes a private key in Bitcoin's Wallet Import Format. It
+// returns whether the key is marked as having a compressed public key. The
+// base58check checksum, the mainnet or testnet version byte and the range
+// of the key are checked.
+func ImportWIF(wif string) (s Scalar, compressed bool, err error) {
+	payload, err := base58CheckDecode(wif)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decode WIF: %w", err)
+	}
+
+	switch len(payload) {
+	case 33:
+	case 34:
+		if payload[33] != 0x01 {
+			return nil, false, errors.New("invalid WIF compression flag")
+		}
+
+		compressed = true
+	default:
+		return nil, false, errors.New("invalid WIF length")
+	}
+
+	if payload[0] != wifMainnet && payload[0] != wifTestnet {
+		return nil, false, fmt.Errorf("unknown WIF network byte 0x%02x", payload[0])
+	}
+
+	curve := NewCurve()
+	key := payload[1:33]
+	k := new(big.Int).SetBytes(key)
+	if k.Sign() == 0 || k.Cmp(curve.Order()) >= 0 {
+		return nil, false, errors.New("WIF key is not in the range of the curve order")
+	}
+
+	s, err = curve.DecodeToScalar(key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	clear(payload)
+	return s, compressed, nil
+}
+
+// ExportWIF encodes a private key in Bitcoin's Wallet Import Format, for
+// mainnet or testnet, marked as having a compressed public key or not.
+func ExportWIF(s Scalar, compressed bool, mainnet bool) string {
+	if _, ok := s.(*ScalarImpl); !ok {
+		panic("invalid scalar; type is not *secp256k1.ScalarImpl")
+	}
+
+	version := byte(wifTestnet)
+	if mainnet {
+		version = wifMainnet
+	}
+
+	payload := append([]byte{version}, s.Encode()...)
+	if compressed {
+		payload = append(payload, 0x01)
+	}
+
+	defer clear(payload)
+	return base58CheckEncode(payload)
+}
@@ -0,0 +1,70 @@
+package secp256k1
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWIF(t *testing.T) {
+	// the example key from the Bitcoin wiki's WIF page
+	key, err := hex.DecodeString("0c28fca386c7a227600b2fe50b7cae11ec86d3bf1fbe471be89827e19d72aa1d")
+	require.NoError(t, err)
+
+	vectors := []struct {
+		wif                 string
+		compressed, mainnet bool
+	}{
+		{"5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ", false, true},
+		{"KwdMAjGmerYanjeui5SHS7JkmpZvVipYvB2LJGU1ZxJwYvP98617", true, true},
+		{"91gGn1HgSap6CbU12F6z3pJri26xzp7Ay1VW6NHCoEayNXwRpu2", false, false},
+		{"cMzLdeGd5vEqxB8B6VFQoRopQ3sLAAvEzDAoQgvX54xwofSWj1fx", true, false},
+	}
+
+	for _, v := range vectors {
+		s, compressed, err := ImportWIF(v.wif)
+		require.NoError(t, err, v.wif)
+		require.Equal(t, key, s.Encode(), v.wif)
+		require.Equal(t, v.compressed, compressed, v.wif)
+		require.Equal(t, v.wif, ExportWIF(s, v.compressed, v.mainnet))
+	}
+
+	curve := NewCurve()
+	for i := 0; i < 16; i++ {
+		s := curve.NewRandomScalar()
+		for _, compressed := range []bool{false, true} {
+			for _, mainnet := range []bool{false, true} {
+				imported, c, err := ImportWIF(ExportWIF(s, compressed, mainnet))
+				require.NoError(t, err)
+				require.True(t, s.Eq(imported))
+				require.Equal(t, compressed, c)
+			}
+		}
+	}
+}
+
+func TestImportWIF_Invalid(t *testing.T) {
+	valid := "KwdMAjGmerYanjeui5SHS7JkmpZvVipYvB2LJGU1ZxJwYvP98617"
+
+	// one character changed breaks the checksum
+	_, _, err := ImportWIF(valid[:10] + "x" + valid[11:])
+	require.ErrorIs(t, err, errInvalidChecksum)
+
+	order := NewCurve().Order().FillBytes(make([]byte, 32))
+	for name, payload := range map[string][]byte{
+		"network byte":     append([]byte{0x00}, make([]byte, 32)...),
+		"compression flag": append(append([]byte{wifMainnet}, order[:31]...), 0x00, 0x02),
+		"length":           append([]byte{wifMainnet}, make([]byte, 31)...),
+		"zero key":         append([]byte{wifMainnet}, make([]byte, 32)...),
+		"key above order":  append([]byte{wifTestnet}, order...),
+	} {
+		_, _, err = ImportWIF(base58CheckEncode(payload))
+		require.Error(t, err, name)
+	}
+
+	for _, wif := range []string{"", "0OIl", "1111"} {
+		_, _, err = ImportWIF(wif)
+		require.Error(t, err, wif)
+	}
+}
@@ -3,12 +3,36 @@ package dleq
 import (
 	"bytes"
 	"errors"
+	"fmt"
 
 	"github.com/pokt-network/go-dleq/types"
 )
 
 var errInputBytesTooShort = errors.New("input bytes too short")
 
+// ErrProofTooLarge is returned when decoding a proof larger than
+// ProofDecodeOptions.MaxSize.
+var ErrProofTooLarge = errors.New("proof exceeds maximum size")
+
+// ProofDecodeOptions configures decoding a proof.
+type ProofDecodeOptions struct {
+	// MaxSize is the largest serialized proof accepted, in bytes, or zero
+	// for no limit beyond the format's own: 255 bit proofs and signatures
+	// of up to 255 bytes. The limit is checked against the lengths the
+	// proof declares, before anything is allocated for them.
+	MaxSize int
+}
+
+// checkSize returns an error if a proof of at least size bytes is over the
+// limit.
+func (opts ProofDecodeOptions) checkSize(size int) error {
+	if opts.MaxSize > 0 && size > opts.MaxSize {
+		return fmt.Errorf("%w: at least %d bytes, limit is %d", ErrProofTooLarge, size, opts.MaxSize)
+	}
+
+	return nil
+}
+
 // Serialize encodes the proof.
 func (p *Proof) Serialize() []byte {
 	b := append(p.CommitmentA.Encode(), p.CommitmentB.Encode()...)
@@ -41,18 +65,22 @@ func (p *bitProof) encode() []byte {
 // Deserialize decodes the proof for the given curves.
 // The curves must match those passed into `NewProof`.
 func (p *Proof) Deserialize(curveA, curveB types.Curve, in []byte) error {
+	return p.DeserializeWithOptions(curveA, curveB, in, ProofDecodeOptions{})
+}
+
+// DeserializeWithOptions is like Deserialize, with the given options.
+func (p *Proof) DeserializeWithOptions(curveA, curveB types.Curve, in []byte, opts ProofDecodeOptions) error {
 	reader := bytes.NewBuffer(in)
 
 	pointLenA := curveA.CompressedPointSize()
 	pointLenB := curveB.CompressedPointSize()
+	scalarLenA := curveA.ScalarSize()
+	scalarLenB := curveB.ScalarSize()
 
 	if len(in) < pointLenA+pointLenB {
 		return errInputBytesTooShort
 	}
 
-	// WARN: this assumes the groups have an encoded scalar length of 32!
-	const scalarLen = 32
-
 	var err error
 	p.CommitmentA, err = curveA.DecodeToPoint(reader.Next(pointLenA))
 	if err != nil {
@@ -70,7 +98,13 @@ func (p *Proof) Deserialize(curveA, curveB types.Curve, in []byte) error {
 	bitProofsLen := reader.Next(1)
 
 	// TODO put bitProofsLen + sigLens first so we know the total expected length?
-	minLenRemaining := (int(bitProofsLen[0]) * (pointLenA + pointLenB + scalarLen*6))
+	minLenRemaining := (int(bitProofsLen[0]) * (pointLenA + pointLenB + scalarLenA*3 + scalarLenB*3))
+	size := pointLenA + pointLenB + 1 + minLenRemaining + 2
+	err = opts.checkSize(size)
+	if err != nil {
+		return err
+	}
+
 	if reader.Len() < minLenRemaining {
 		return errInputBytesTooShort
 	}
@@ -78,7 +112,7 @@ func (p *Proof) Deserialize(curveA, curveB types.Curve, in []byte) error {
 	p.proofs = make([]bitProof, bitProofsLen[0])
 	for i := 0; i < int(bitProofsLen[0]); i++ {
 		bp := new(bitProof)
-		err = bp.decode(reader, curveA, curveB, scalarLen)
+		err = bp.decode(reader, curveA, curveB)
 		if err != nil {
 			return err
 		}
@@ -90,6 +124,12 @@ func (p *Proof) Deserialize(curveA, curveB types.Curve, in []byte) error {
 	}
 
 	sigLen := reader.Next(1)
+	size += int(sigLen[0])
+	err = opts.checkSize(size)
+	if err != nil {
+		return err
+	}
+
 	if reader.Len() < int(sigLen[0]) {
 		return errInputBytesTooShort
 	}
@@ -102,18 +142,27 @@ func (p *Proof) Deserialize(curveA, curveB types.Curve, in []byte) error {
 	}
 
 	sigLen = reader.Next(1)
+	size += int(sigLen[0])
+	err = opts.checkSize(size)
+	if err != nil {
+		return err
+	}
+
 	if reader.Len() < int(sigLen[0]) {
 		return errInputBytesTooShort
 	}
 
 	p.signatureB.inner = make([]byte, sigLen[0])
 	copy(p.signatureB.inner, reader.Next(int(sigLen[0])))
+	p.setCurves(curveA, curveB)
 	return nil
 }
 
-func (p *bitProof) decode(r *bytes.Buffer, curveA, curveB types.Curve, scalarLen int) error {
+func (p *bitProof) decode(r *bytes.Buffer, curveA, curveB types.Curve) error {
 	pointLenA := curveA.CompressedPointSize()
 	pointLenB := curveB.CompressedPointSize()
+	scalarLenA := curveA.ScalarSize()
+	scalarLenB := curveB.ScalarSize()
 
 	var err error
 	p.commitmentA.commitment, err = curveA.DecodeToPoint(r.Next(pointLenA))
@@ -126,32 +175,32 @@ func (p *bitProof) decode(r *bytes.Buffer, curveA, curveB types.Curve, scalarLen
 		return err
 	}
 
-	p.ringSig.eCurveA, err = curveA.DecodeToScalar(r.Next(scalarLen))
+	p.ringSig.eCurveA, err = curveA.DecodeToScalar(r.Next(scalarLenA))
 	if err != nil {
 		return err
 	}
 
-	p.ringSig.eCurveB, err = curveB.DecodeToScalar(r.Next(scalarLen))
+	p.ringSig.eCurveB, err = curveB.DecodeToScalar(r.Next(scalarLenB))
 	if err != nil {
 		return err
 	}
 
-	p.ringSig.a0, err = curveA.DecodeToScalar(r.Next(scalarLen))
+	p.ringSig.a0, err = curveA.DecodeToScalar(r.Next(scalarLenA))
 	if err != nil {
 		return err
 	}
 
-	p.ringSig.a1, err = curveA.DecodeToScalar(r.Next(scalarLen))
+	p.ringSig.a1, err = curveA.DecodeToScalar(r.Next(scalarLenA))
 	if err != nil {
 		return err
 	}
 
-	p.ringSig.b0, err = curveB.DecodeToScalar(r.Next(scalarLen))
+	p.ringSig.b0, err = curveB.DecodeToScalar(r.Next(scalarLenB))
 	if err != nil {
 		return err
 	}
 
-	p.ringSig.b1, err = curveB.DecodeToScalar(r.Next(scalarLen))
+	p.ringSig.b1, err = curveB.DecodeToScalar(r.Next(scalarLenB))
 	if err != nil {
 		return err
 	}
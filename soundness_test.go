@@ -0,0 +1,155 @@
+package dleq
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+// Transcript is one accepting run of the Σ-protocol underlying the ring
+// signatures: the prover knows w with Statement = w*Base, sends
+// Commitment = j*Base, receives Challenge e and responds with j + e*w.
+// This is the relation each branch of a bit proof is built from.
+type Transcript struct {
+	Curve      Curve
+	Base       Point
+	Statement  Point
+	Commitment Point
+	Challenge  Scalar
+	Response   Scalar
+}
+
+func (t *Transcript) accepts() bool {
+	// Response*Base == Commitment + Challenge*Statement
+	lhs := t.Curve.ScalarMul(t.Response, t.Base)
+	rhs := t.Commitment.Add(t.Statement.ScalarMul(t.Challenge))
+	return lhs.Equals(rhs)
+}
+
+// ExtractWitness is the special-soundness extractor: given two accepting
+// transcripts for the same statement with the same commitment and different
+// challenges it returns w = (s1 - s2) / (e1 - e2).
+func ExtractWitness(t1, t2 Transcript) (Scalar, error) {
+	if !t1.Base.Equals(t2.Base) || !t1.Statement.Equals(t2.Statement) {
+		return nil, errors.New("transcripts are for different statements")
+	}
+
+	if !t1.Commitment.Equals(t2.Commitment) {
+		return nil, errors.New("transcripts have different commitments")
+	}
+
+	if t1.Challenge.Eq(t2.Challenge) {
+		return nil, errors.New("transcripts have the same challenge")
+	}
+
+	if !t1.accepts() || !t2.accepts() {
+		return nil, errors.New("transcript does not verify")
+	}
+
+	de := t1.Challenge.Sub(t2.Challenge)
+	ds := t1.Response.Sub(t2.Response)
+	return ds.Mul(de.Inverse()), nil
+}
+
+// rewind runs the prover twice with the same nonce, as an extractor rewinding
+// it would, and returns the two transcripts.
+func rewind(curve Curve, base, statement Point, w Scalar) (Transcript, Transcript) {
+	j := curve.NewRandomScalar()
+	R := curve.ScalarMul(j, base)
+
+	run := func() Transcript {
+		e := curve.NewRandomScalar()
+		return Transcript{
+			Curve:      curve,
+			Base:       base,
+			Statement:  statement,
+			Commitment: R,
+			Challenge:  e,
+			Response:   j.Add(e.Mul(w)),
+		}
+	}
+
+	return run(), run()
+}
+
+func TestExtractWitness(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+
+	for _, curve := range []Curve{curveA, curveB} {
+		x, err := GenerateSecretForCurves(curveA, curveB)
+		require.NoError(t, err)
+		bits := min(curveA.BitSize(), curveB.BitSize())
+
+		commitments, err := generateCommitments(curve, x[:], bits, systemRandom)
+		require.NoError(t, err)
+
+		// for every bit commitment C_i = b_i*G + r_i*H, extract r_i from the
+		// branch the prover knows and recover b_i from it
+		var extracted [32]byte
+		for i, c := range commitments {
+			bit := getBit(x[:], uint64(i))
+			statement := c.commitment
+			if bit == 1 {
+				statement = statement.Sub(curve.BasePoint())
+			}
+
+			t1, t2 := rewind(curve, curve.AltBasePoint(), statement, c.blinder)
+			r, err := ExtractWitness(t1, t2)
+			require.NoError(t, err)
+			require.True(t, r.Eq(c.blinder))
+
+			rH := curve.ScalarMul(r, curve.AltBasePoint())
+			switch {
+			case c.commitment.Equals(rH):
+			case c.commitment.Sub(rH).Equals(curve.BasePoint()):
+				extracted[i/8] |= 1 << (i % 8)
+			default:
+				t.Fatalf("commitment %d does not open to a bit", i)
+			}
+		}
+
+		require.Equal(t, x, extracted)
+
+		// the witness of the public key itself
+		w := curve.ScalarFromBytes(x)
+		X := curve.ScalarBaseMul(w)
+		t1, t2 := rewind(curve, curve.BasePoint(), X, w)
+		xExtracted, err := ExtractWitness(t1, t2)
+		require.NoError(t, err)
+		require.True(t, xExtracted.Eq(w))
+	}
+}
+
+func TestExtractWitness_Invalid(t *testing.T) {
+	curve := secp256k1.NewCurve()
+	w := curve.NewRandomScalar()
+	X := curve.ScalarBaseMul(w)
+
+	t1, t2 := rewind(curve, curve.BasePoint(), X, w)
+
+	// same challenge twice
+	_, err := ExtractWitness(t1, t1)
+	require.Error(t, err)
+
+	// different commitments
+	t3, _ := rewind(curve, curve.BasePoint(), X, w)
+	_, err = ExtractWitness(t1, t3)
+	require.Error(t, err)
+
+	// a transcript that doesn't verify
+	bad := t2
+	bad.Response = bad.Response.Add(curve.ScalarFromInt(1))
+	_, err = ExtractWitness(t1, bad)
+	require.Error(t, err)
+
+	// a different statement
+	other := t2
+	other.Statement = curve.ScalarBaseMul(curve.NewRandomScalar())
+	_, err = ExtractWitness(t1, other)
+	require.Error(t, err)
+}
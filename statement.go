@@ -0,0 +1,96 @@
+package dleq
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	statementDomain          = "go-dleq/statement/v1"
+	aggregateChallengeDomain = "go-dleq/aggregate-challenge/v1"
+)
+
+// StatementHash returns a digest of what a proof proves: that pointA on
+// curveA and pointB on curveB share a discrete log. It covers the curve
+// names and the encoded points but not the proof itself, so it can be
+// committed to before the proof is revealed.
+func StatementHash(curveA, curveB Curve, pointA, pointB Point) [32]byte {
+	h := sha3.New256()
+	for _, b := range [][]byte{
+		[]byte(statementDomain),
+		[]byte(curveA.Name()),
+		[]byte(curveB.Name()),
+		pointA.Encode(),
+		pointB.Encode(),
+	} {
+		var l [8]byte
+		binary.BigEndian.PutUint64(l[:], uint64(len(b)))
+		h.Write(l[:])
+		h.Write(b)
+	}
+
+	var out [32]byte
+	h.Sum(out[:0])
+	return out
+}
+
+// ProofIDForSecret returns an identifier for proofs of the given secret on
+// the given curves, eg. to detect that a proof was already generated when
+// retrying. Proofs are randomized, so this doesn't identify a particular
+// proof; it's the StatementHash of the secret's public keys, and so can also
+// be computed from a proof with StatementHash(curveA, curveB,
+// proof.CommitmentA, proof.CommitmentB). Like PublicPointsForSecret, it
+// rejects a secret that NewProof would reject.
+func ProofIDForSecret(curveA, curveB Curve, secret [32]byte) ([32]byte, error) {
+	pointA, pointB, err := PublicPointsForSecret(curveA, curveB, secret)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return StatementHash(curveA, curveB, pointA, pointB), nil
+}
+
+// PublicPointsForSecret returns the statement a proof of the given secret
+// attests to, secret*G on each curve, without generating a proof. The
+// secret is read like NewProof reads it, and the same size check applies,
+// so a secret that NewProof would reject is rejected here too.
+func PublicPointsForSecret(curveA, curveB Curve, secret [32]byte) (pointA, pointB Point, err error) {
+	err = checkWitnessSize(secret, min(curveA.BitSize(), curveB.BitSize()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	xA := curveA.ScalarFromBytes(secret)
+	xB := curveB.ScalarFromBytes(secret)
+	defer xA.Zeroize()
+	defer xB.Zeroize()
+
+	return curveA.ScalarBaseMul(xA), curveB.ScalarBaseMul(xB), nil
+}
+
+// AggregateChallenge returns a challenge on curve binding the given proofs
+// together, in order: the hash to a scalar of their serializations, each
+// prefixed with its length. Reordering, adding or removing a proof changes
+// the challenge.
+func AggregateChallenge(curve Curve, proofs []*Proof) (Scalar, error) {
+	if len(proofs) == 0 {
+		return nil, errors.New("no proofs to aggregate")
+	}
+
+	preimage := binary.BigEndian.AppendUint64(nil, uint64(len(aggregateChallengeDomain)))
+	preimage = append(preimage, aggregateChallengeDomain...)
+	for i, p := range proofs {
+		if p == nil {
+			return nil, fmt.Errorf("proof %d is nil", i)
+		}
+
+		ser := p.Serialize()
+		preimage = binary.BigEndian.AppendUint64(preimage, uint64(len(ser)))
+		preimage = append(preimage, ser...)
+	}
+
+	return curve.HashToScalar(preimage)
+}
@@ -0,0 +1,146 @@
+package dleq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+func TestStatementHash(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	h := StatementHash(curveA, curveB, proof.CommitmentA, proof.CommitmentB)
+
+	// stable across calls, curve instances and point copies
+	require.Equal(t, h, StatementHash(curveA, curveB, proof.CommitmentA, proof.CommitmentB))
+	require.Equal(t, h, StatementHash(secp256k1.NewCurve(), ed25519.NewCurve(), proof.CommitmentA.Copy(), proof.CommitmentB.Copy()))
+
+	// a new proof of the same statement has the same hash
+	other, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+	require.Equal(t, h, StatementHash(curveA, curveB, other.CommitmentA, other.CommitmentB))
+
+	// changing any input changes the hash
+	otherA := curveA.ScalarBaseMul(curveA.NewRandomScalar())
+	otherB := curveB.ScalarBaseMul(curveB.NewRandomScalar())
+	require.NotEqual(t, h, StatementHash(curveA, curveB, otherA, proof.CommitmentB))
+	require.NotEqual(t, h, StatementHash(curveA, curveB, proof.CommitmentA, otherB))
+	require.NotEqual(t, h, StatementHash(curveB, curveA, proof.CommitmentA, proof.CommitmentB))
+	require.NotEqual(t, h, StatementHash(curveA, curveA, proof.CommitmentA, proof.CommitmentB))
+}
+
+func TestProofIDForSecret(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+
+	id, err := ProofIDForSecret(curveA, curveB, x)
+	require.NoError(t, err)
+	again, err := ProofIDForSecret(curveA, curveB, x)
+	require.NoError(t, err)
+	require.Equal(t, id, again)
+
+	// the same for every proof of the secret
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+	require.Equal(t, id, StatementHash(curveA, curveB, proof.CommitmentA, proof.CommitmentB))
+
+	other, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	otherID, err := ProofIDForSecret(curveA, curveB, other)
+	require.NoError(t, err)
+	require.NotEqual(t, id, otherID)
+
+	// and bound to the order of the curves
+	swapped, err := ProofIDForSecret(curveB, curveA, x)
+	require.NoError(t, err)
+	require.NotEqual(t, id, swapped)
+
+	// out of range for ed25519, which ScalarFromBytes would panic on
+	var outOfRange [32]byte
+	for i := range outOfRange {
+		outOfRange[i] = 0xff
+	}
+	_, err = ProofIDForSecret(curveA, curveB, outOfRange)
+	require.Error(t, err)
+	_, err = ProofIDForSecret(curveB, curveA, outOfRange)
+	require.Error(t, err)
+}
+
+func TestPublicPointsForSecret(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+
+	pointA, pointB, err := PublicPointsForSecret(curveA, curveB, x)
+	require.NoError(t, err)
+
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+	require.True(t, pointA.Equals(proof.CommitmentA))
+	require.True(t, pointB.Equals(proof.CommitmentB))
+	require.NoError(t, proof.VerifyAgainst(curveA, curveB, pointA, pointB))
+	id, err := ProofIDForSecret(curveA, curveB, x)
+	require.NoError(t, err)
+	require.Equal(t, id, StatementHash(curveA, curveB, pointA, pointB))
+
+	// too large for the smaller curve, like NewProof
+	x[31] = 0xff
+	_, _, err = PublicPointsForSecret(curveA, curveB, x)
+	require.Error(t, err)
+	_, err = NewProof(curveA, curveB, x)
+	require.Error(t, err)
+}
+
+func TestAggregateChallenge(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+
+	proofs := make([]*Proof, 3)
+	for i := range proofs {
+		x, err := GenerateSecretForCurves(curveA, curveB)
+		require.NoError(t, err)
+		proofs[i], err = NewProof(curveA, curveB, x)
+		require.NoError(t, err)
+	}
+
+	for _, curve := range []Curve{curveA, curveB} {
+		e, err := AggregateChallenge(curve, proofs)
+		require.NoError(t, err)
+
+		// deterministic, including for decoded copies of the proofs
+		decoded := make([]*Proof, len(proofs))
+		for i, p := range proofs {
+			decoded[i] = new(Proof)
+			require.NoError(t, decoded[i].Deserialize(curveA, curveB, p.Serialize()))
+		}
+
+		again, err := AggregateChallenge(curve, decoded)
+		require.NoError(t, err)
+		require.True(t, e.Eq(again))
+
+		// sensitive to the order and the set of proofs
+		swapped, err := AggregateChallenge(curve, []*Proof{proofs[1], proofs[0], proofs[2]})
+		require.NoError(t, err)
+		require.False(t, e.Eq(swapped))
+
+		fewer, err := AggregateChallenge(curve, proofs[:2])
+		require.NoError(t, err)
+		require.False(t, e.Eq(fewer))
+
+		_, err = AggregateChallenge(curve, nil)
+		require.Error(t, err)
+		_, err = AggregateChallenge(curve, []*Proof{proofs[0], nil})
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,133 @@
+package dleq
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/pokt-network/go-dleq/types"
+)
+
+var _ io.WriterTo = &Proof{}
+
+// WriteTo writes the proof to w in the format of Serialize, one bit proof
+// at a time, so the whole serialization is never held in memory. It returns
+// the number of bytes written.
+func (p *Proof) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	write := func(b []byte) error {
+		m, err := w.Write(b)
+		n += int64(m)
+		return err
+	}
+
+	header := append(p.CommitmentA.Encode(), p.CommitmentB.Encode()...)
+	err := write(append(header, byte(len(p.proofs))))
+	if err != nil {
+		return n, err
+	}
+
+	for _, bp := range p.proofs {
+		err = write(bp.encode())
+		if err != nil {
+			return n, err
+		}
+	}
+
+	for _, sig := range []signature{p.signatureA, p.signatureB} {
+		err = write(append([]byte{byte(len(sig.inner))}, sig.inner...))
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// ReadProofFrom reads a proof written by WriteTo or Serialize from r, one
+// bit proof at a time. It reads exactly the proof's bytes, so anything after
+// the proof is left in r, and returns io.ErrUnexpectedEOF if r ends before
+// the proof does. The curves must match those passed into NewProof.
+func ReadProofFrom(r io.Reader, curveA, curveB types.Curve) (*Proof, error) {
+	return ReadProofFromWithOptions(r, curveA, curveB, ProofDecodeOptions{})
+}
+
+// ReadProofFromWithOptions is like ReadProofFrom, with the given options.
+// A proof declaring more than MaxSize bytes is rejected before the rest of
+// it is read.
+func ReadProofFromWithOptions(r io.Reader, curveA, curveB types.Curve, opts ProofDecodeOptions) (*Proof, error) {
+	pointLenA := curveA.CompressedPointSize()
+	pointLenB := curveB.CompressedPointSize()
+	bitProofLen := pointLenA + pointLenB + curveA.ScalarSize()*3 + curveB.ScalarSize()*3
+
+	buf := make([]byte, max(pointLenA+pointLenB+1, bitProofLen))
+	read := func(n int) ([]byte, error) {
+		_, err := io.ReadFull(r, buf[:n])
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+
+		return buf[:n], err
+	}
+
+	header, err := read(pointLenA + pointLenB + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	p := new(Proof)
+	p.CommitmentA, err = curveA.DecodeToPoint(header[:pointLenA])
+	if err != nil {
+		return nil, err
+	}
+
+	p.CommitmentB, err = curveB.DecodeToPoint(header[pointLenA : pointLenA+pointLenB])
+	if err != nil {
+		return nil, err
+	}
+
+	size := len(header) + int(header[pointLenA+pointLenB])*bitProofLen + 2
+	err = opts.checkSize(size)
+	if err != nil {
+		return nil, err
+	}
+
+	p.proofs = make([]bitProof, header[pointLenA+pointLenB])
+	for i := range p.proofs {
+		b, err := read(bitProofLen)
+		if err != nil {
+			return nil, err
+		}
+
+		err = p.proofs[i].decode(bytes.NewBuffer(b), curveA, curveB)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode bit proof %d: %w", i, err)
+		}
+	}
+
+	for _, sig := range []*signature{&p.signatureA, &p.signatureB} {
+		sigLen, err := read(1)
+		if err != nil {
+			return nil, err
+		}
+
+		size += int(sigLen[0])
+		err = opts.checkSize(size)
+		if err != nil {
+			return nil, err
+		}
+
+		sig.inner = make([]byte, sigLen[0])
+		_, err = io.ReadFull(r, sig.inner)
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p.setCurves(curveA, curveB)
+	return p, nil
+}
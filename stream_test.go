@@ -0,0 +1,113 @@
+package dleq
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+func TestProof_WriteToReadProofFrom(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	pr, pw := io.Pipe()
+	written := make(chan int64, 1)
+	go func() {
+		n, err := proof.WriteTo(pw)
+		pw.CloseWithError(err)
+		written <- n
+	}()
+
+	read, err := ReadProofFrom(pr, curveA, curveB)
+	require.NoError(t, err)
+	require.NoError(t, read.Verify(curveA, curveB))
+	require.Equal(t, proof.Serialize(), read.Serialize())
+	require.Equal(t, proof.Parameters(), read.Parameters())
+
+	// the proof has been read in full, so the writer is done
+	n, err := pr.Read(make([]byte, 1))
+	require.Zero(t, n)
+	require.ErrorIs(t, err, io.EOF)
+	require.Equal(t, int64(len(proof.Serialize())), <-written)
+}
+
+func TestReadProofFrom_Stream(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+	ser := proof.Serialize()
+
+	// anything after the proof is left unread
+	var buf bytes.Buffer
+	_, err = proof.WriteTo(&buf)
+	require.NoError(t, err)
+	require.Equal(t, ser, buf.Bytes())
+	buf.WriteString("next message")
+
+	read, err := ReadProofFrom(&buf, curveA, curveB)
+	require.NoError(t, err)
+	require.NoError(t, read.Verify(curveA, curveB))
+	require.Equal(t, "next message", buf.String())
+
+	// truncated anywhere, including right after a field
+	for _, l := range []int{0, 1, 33, 33 + 32 + 1, 100, len(ser) - 1} {
+		_, err = ReadProofFrom(bytes.NewReader(ser[:l]), curveA, curveB)
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF, "length %d", l)
+	}
+}
+
+func TestReadProofFromWithOptions_MaxSize(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+	ser := proof.Serialize()
+
+	opts := ProofDecodeOptions{MaxSize: len(ser)}
+	read, err := ReadProofFromWithOptions(bytes.NewReader(ser), curveA, curveB, opts)
+	require.NoError(t, err)
+	require.NoError(t, read.Verify(curveA, curveB))
+
+	var p Proof
+	require.NoError(t, p.DeserializeWithOptions(curveA, curveB, ser, opts))
+	bin, err := proof.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, p.UnmarshalBinaryWithOptions(bin, opts))
+
+	// one byte less rejects the proof, either for its signatures or its bit
+	// proofs
+	opts.MaxSize--
+	_, err = ReadProofFromWithOptions(bytes.NewReader(ser), curveA, curveB, opts)
+	require.ErrorIs(t, err, ErrProofTooLarge)
+	require.ErrorIs(t, p.DeserializeWithOptions(curveA, curveB, ser, opts), ErrProofTooLarge)
+	require.ErrorIs(t, p.UnmarshalBinaryWithOptions(bin, opts), ErrProofTooLarge)
+
+	// a header claiming 255 bit proofs is rejected before any of them are read
+	headerLen := curveA.CompressedPointSize() + curveB.CompressedPointSize() + 1
+	header := append([]byte{}, ser[:headerLen]...)
+	header[headerLen-1] = 255
+	r := bytes.NewReader(header)
+	opts.MaxSize = 1 << 10
+	_, err = ReadProofFromWithOptions(r, curveA, curveB, opts)
+	require.ErrorIs(t, err, ErrProofTooLarge)
+	require.Zero(t, r.Len())
+	require.ErrorIs(t, p.DeserializeWithOptions(curveA, curveB, header, opts), ErrProofTooLarge)
+
+	// without a limit the same header only fails for being truncated
+	_, err = ReadProofFrom(bytes.NewReader(header), curveA, curveB)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,107 @@
+package swap
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/pokt-network/go-dleq/keys"
+	"github.com/pokt-network/go-dleq/types"
+)
+
+const signatureDomain = "go-dleq/swap/schnorr/v1"
+
+// Signature is a Schnorr signature (R, s) by a key X over a message, valid
+// if s*G = R + e*X with e the challenge of R, X and the message.
+type Signature struct {
+	R types.Point
+	S types.Scalar
+}
+
+// AdaptorSignature is a Schnorr pre-signature encrypted to an adaptor point
+// T = t*G: (R, s') with R = k*G + T and s' = k + e*x. It isn't a valid
+// signature, but adding t to s' makes it one, and subtracting s' from the
+// s of that signature gives back t.
+type AdaptorSignature struct {
+	R types.Point
+	S types.Scalar
+}
+
+// NewAdaptorSignature pre-signs msg with key, encrypted to the adaptor
+// point.
+func NewAdaptorSignature(key *keys.PrivateKey, adaptor types.Point, msg []byte) (*AdaptorSignature, error) {
+	curve := key.Curve()
+	k := curve.NewRandomScalar()
+	defer k.Zeroize()
+
+	R := curve.ScalarBaseMul(k).Add(adaptor)
+	if R.IsZero() {
+		return nil, errors.New("nonce commitment is the identity")
+	}
+
+	e, err := challenge(curve, R, key.Public().Point(), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AdaptorSignature{
+		R: R,
+		S: k.Add(e.Mul(key.Scalar())),
+	}, nil
+}
+
+// Verify reports whether the pre-signature is by pub over msg and encrypted
+// to the adaptor point, ie. whether s'*G = R - T + e*X, so that completing
+// it with the discrete log of T gives a valid signature.
+func (a *AdaptorSignature) Verify(pub *keys.PublicKey, adaptor types.Point, msg []byte) bool {
+	curve := pub.Curve()
+	e, err := challenge(curve, a.R, pub.Point(), msg)
+	if err != nil {
+		return false
+	}
+
+	expected := a.R.Sub(adaptor).Add(pub.Point().ScalarMul(e))
+	return curve.ScalarBaseMul(a.S).Equals(expected)
+}
+
+// Complete returns the signature made by adding the adaptor secret t to
+// the pre-signature. Publishing it reveals t to whoever holds the
+// pre-signature.
+func (a *AdaptorSignature) Complete(t types.Scalar) *Signature {
+	return &Signature{
+		R: a.R,
+		S: a.S.Add(t),
+	}
+}
+
+// Recover returns the adaptor secret t from a signature completed from the
+// pre-signature.
+func (a *AdaptorSignature) Recover(sig *Signature) (types.Scalar, error) {
+	if !sig.R.Equals(a.R) {
+		return nil, errors.New("signature was not completed from the adaptor signature")
+	}
+
+	return sig.S.Sub(a.S), nil
+}
+
+// Verify reports whether the signature is by pub over msg.
+func (sig *Signature) Verify(pub *keys.PublicKey, msg []byte) bool {
+	curve := pub.Curve()
+	e, err := challenge(curve, sig.R, pub.Point(), msg)
+	if err != nil {
+		return false
+	}
+
+	return curve.ScalarBaseMul(sig.S).Equals(sig.R.Add(pub.Point().ScalarMul(e)))
+}
+
+// challenge hashes the domain, R, X and msg, each prefixed with its length,
+// to a scalar.
+func challenge(curve types.Curve, R, X types.Point, msg []byte) (types.Scalar, error) {
+	var preimage []byte
+	for _, b := range [][]byte{[]byte(signatureDomain), R.Encode(), X.Encode(), msg} {
+		preimage = binary.BigEndian.AppendUint64(preimage, uint64(len(b)))
+		preimage = append(preimage, b...)
+	}
+
+	return curve.HashToScalar(preimage)
+}
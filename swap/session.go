@@ -0,0 +1,238 @@
+// Package swap ties DLEQ proofs and adaptor signatures together into the
+// key exchange of an atomic swap between two chains on different curves.
+//
+// One party, the secret holder, commits to a secret t valid on both curves
+// and proves with a DLEQ proof that t*G on curve A and t*G on curve B share
+// it. The other party, having checked the proof, pre-signs a message on
+// curve A, eg. the transaction paying the secret holder, with an adaptor
+// signature encrypted to t*G. The secret holder can only complete and
+// publish that signature by revealing t, from which the other party
+// recovers the key to the funds locked on curve B. If the secret holder
+// never completes the signature, both sides refund instead.
+//
+// Only the key exchange is covered: locking funds, timelocks and publishing
+// transactions are up to the caller.
+package swap
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	dleq "github.com/pokt-network/go-dleq"
+	"github.com/pokt-network/go-dleq/keys"
+	"github.com/pokt-network/go-dleq/types"
+)
+
+// ErrRefunded is returned by every method of a refunded session.
+var ErrRefunded = errors.New("swap session was refunded")
+
+// Session is one party's side of a swap. The secret holder calls
+// CommitSecret, ProveEquality and CompleteAndReveal; the other party calls
+// CreateAdaptor and RecoverCounterpartySecret. The adaptor signatures are
+// on curve A. A session must only be used by one goroutine at a time.
+type Session struct {
+	curveA, curveB types.Curve
+
+	// the secret holder's side
+	secret         [32]byte
+	committed      bool
+	pointA, pointB types.Point
+	proof          *dleq.Proof
+	revealed       bool
+
+	// the other party's side
+	counterpartyA, counterpartyB types.Point
+	adaptor                      *AdaptorSignature
+	adaptorKey                   *keys.PublicKey
+	adaptorMsg                   []byte
+
+	refunded bool
+}
+
+// NewSession returns a session for a swap between curveA, where the adaptor
+// signatures are made, and curveB.
+func NewSession(curveA, curveB types.Curve) *Session {
+	return &Session{
+		curveA: curveA,
+		curveB: curveB,
+	}
+}
+
+// CommitSecret generates the secret and returns its public points on both
+// curves, eg. to lock funds on curve B to pointB.
+func (s *Session) CommitSecret() (pointA, pointB types.Point, err error) {
+	if s.refunded {
+		return nil, nil, ErrRefunded
+	}
+
+	if s.committed {
+		return nil, nil, errors.New("secret already committed")
+	}
+
+	secret, err := dleq.GenerateSecretForCurves(s.curveA, s.curveB)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pointA, pointB, err = dleq.PublicPointsForSecret(s.curveA, s.curveB, secret)
+	if err != nil {
+		clear(secret[:])
+		return nil, nil, err
+	}
+
+	s.secret = secret
+	s.committed = true
+	s.pointA, s.pointB = pointA, pointB
+	return pointA, pointB, nil
+}
+
+// ProveEquality returns a proof that the committed secret's points on both
+// curves share their discrete log, for the other party's CreateAdaptor. The
+// proof is only made once.
+func (s *Session) ProveEquality() (*dleq.Proof, error) {
+	if s.refunded {
+		return nil, ErrRefunded
+	}
+
+	if !s.committed {
+		return nil, errors.New("no secret committed")
+	}
+
+	if s.proof == nil {
+		proof, err := dleq.NewProof(s.curveA, s.curveB, s.secret)
+		if err != nil {
+			return nil, err
+		}
+
+		s.proof = proof
+	}
+
+	return s.proof, nil
+}
+
+// CreateAdaptor verifies the secret holder's proof and pre-signs msg with
+// key on curve A, encrypted to the proven point on curve A. The secret
+// holder completes it with CompleteAndReveal, and the completed signature
+// is passed to RecoverCounterpartySecret.
+func (s *Session) CreateAdaptor(key *keys.PrivateKey, msg []byte, counterpartyProof *dleq.Proof) (*AdaptorSignature, error) {
+	if s.refunded {
+		return nil, ErrRefunded
+	}
+
+	if s.adaptor != nil {
+		return nil, errors.New("adaptor signature already created")
+	}
+
+	if !key.Curve().Equal(s.curveA) {
+		return nil, fmt.Errorf("signing key is on %s, not curve A", key.Curve().Name())
+	}
+
+	err := counterpartyProof.Verify(s.curveA, s.curveB)
+	if err != nil {
+		return nil, fmt.Errorf("invalid counterparty proof: %w", err)
+	}
+
+	adaptor, err := NewAdaptorSignature(key, counterpartyProof.CommitmentA, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	s.counterpartyA = counterpartyProof.CommitmentA
+	s.counterpartyB = counterpartyProof.CommitmentB
+	s.adaptor = adaptor
+	s.adaptorKey = key.Public()
+	s.adaptorMsg = slices.Clone(msg)
+	return adaptor, nil
+}
+
+// CompleteAndReveal completes the other party's pre-signature over msg by
+// pub with the committed secret, returning a valid signature to publish.
+// The signature reveals the secret to the other party. The pre-signature
+// is verified first, as completing one that isn't encrypted to this
+// session's secret could reveal the secret without giving a valid
+// signature in return.
+func (s *Session) CompleteAndReveal(pub *keys.PublicKey, msg []byte, adaptor *AdaptorSignature) (*Signature, error) {
+	if s.refunded {
+		return nil, ErrRefunded
+	}
+
+	if !s.committed {
+		return nil, errors.New("no secret committed")
+	}
+
+	if !pub.Curve().Equal(s.curveA) {
+		return nil, fmt.Errorf("public key is on %s, not curve A", pub.Curve().Name())
+	}
+
+	if !adaptor.Verify(pub, s.pointA, msg) {
+		return nil, errors.New("invalid adaptor signature")
+	}
+
+	t := s.curveA.ScalarFromBytes(s.secret)
+	defer t.Zeroize()
+
+	sig := adaptor.Complete(t)
+	s.revealed = true
+	return sig, nil
+}
+
+// RecoverCounterpartySecret recovers the secret holder's secret from the
+// signature completed from this session's pre-signature, eg. as published
+// on chain. The secret is in the little-endian form NewProof takes, and is
+// the discrete log of the proven points on both curves.
+func (s *Session) RecoverCounterpartySecret(sig *Signature) ([32]byte, error) {
+	var secret [32]byte
+	if s.refunded {
+		return secret, ErrRefunded
+	}
+
+	if s.adaptor == nil {
+		return secret, errors.New("no adaptor signature created")
+	}
+
+	if !sig.Verify(s.adaptorKey, s.adaptorMsg) {
+		return secret, errors.New("invalid signature")
+	}
+
+	t, err := s.adaptor.Recover(sig)
+	if err != nil {
+		return secret, err
+	}
+	defer t.Zeroize()
+
+	copy(secret[:], t.Encode())
+	if s.curveA.ScalarFromInt(1).Encode()[0] != 1 {
+		slices.Reverse(secret[:])
+	}
+
+	// the proof guarantees the point on curve B, but check both anyway
+	// before anyone relies on the secret
+	pointA, pointB, err := dleq.PublicPointsForSecret(s.curveA, s.curveB, secret)
+	if err != nil || !pointA.Equals(s.counterpartyA) || !pointB.Equals(s.counterpartyB) {
+		clear(secret[:])
+		return secret, errors.New("recovered secret doesn't match the proven points")
+	}
+
+	return secret, nil
+}
+
+// Refund abandons the swap, eg. once its timelock expires without the
+// secret being revealed, and wipes the secret. Every later call on the
+// session returns ErrRefunded. The secret holder can't refund once
+// CompleteAndReveal revealed the secret, as the other party can then claim
+// the funds on curve B.
+func (s *Session) Refund() error {
+	if s.refunded {
+		return ErrRefunded
+	}
+
+	if s.revealed {
+		return errors.New("secret already revealed")
+	}
+
+	clear(s.secret[:])
+	s.refunded = true
+	s.adaptor = nil
+	return nil
+}
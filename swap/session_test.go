@@ -0,0 +1,193 @@
+package swap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dleq "github.com/pokt-network/go-dleq"
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/keys"
+	"github.com/pokt-network/go-dleq/secp256k1"
+	"github.com/pokt-network/go-dleq/types"
+)
+
+func curvePairs() [][2]types.Curve {
+	return [][2]types.Curve{
+		{secp256k1.NewCurve(), ed25519.NewCurve()},
+		{ed25519.NewCurve(), secp256k1.NewCurve()},
+	}
+}
+
+func TestSession_HappyPath(t *testing.T) {
+	msg := []byte("claim transaction")
+	for _, curves := range curvePairs() {
+		curveA, curveB := curves[0], curves[1]
+		holder := NewSession(curveA, curveB)
+		other := NewSession(curveA, curveB)
+
+		pointA, pointB, err := holder.CommitSecret()
+		require.NoError(t, err)
+		_, _, err = holder.CommitSecret()
+		require.Error(t, err)
+
+		proof, err := holder.ProveEquality()
+		require.NoError(t, err)
+		require.True(t, proof.CommitmentA.Equals(pointA))
+		require.True(t, proof.CommitmentB.Equals(pointB))
+
+		key, err := keys.GeneratePrivateKey(curveA)
+		require.NoError(t, err)
+		adaptor, err := other.CreateAdaptor(key, msg, proof)
+		require.NoError(t, err)
+		require.True(t, adaptor.Verify(key.Public(), pointA, msg))
+
+		// the pre-signature alone isn't a valid signature
+		require.False(t, (&Signature{R: adaptor.R, S: adaptor.S}).Verify(key.Public(), msg))
+
+		sig, err := holder.CompleteAndReveal(key.Public(), msg, adaptor)
+		require.NoError(t, err)
+		require.True(t, sig.Verify(key.Public(), msg))
+		require.False(t, sig.Verify(key.Public(), []byte("another transaction")))
+
+		secret, err := other.RecoverCounterpartySecret(sig)
+		require.NoError(t, err)
+		recoveredA, recoveredB, err := dleq.PublicPointsForSecret(curveA, curveB, secret)
+		require.NoError(t, err)
+		require.True(t, recoveredA.Equals(pointA))
+		require.True(t, recoveredB.Equals(pointB))
+
+		// the secret holder can't back out once the secret is revealed
+		require.Error(t, holder.Refund())
+	}
+}
+
+func TestSession_RefundPath(t *testing.T) {
+	msg := []byte("claim transaction")
+	for _, curves := range curvePairs() {
+		curveA, curveB := curves[0], curves[1]
+		holder := NewSession(curveA, curveB)
+		other := NewSession(curveA, curveB)
+
+		_, _, err := holder.CommitSecret()
+		require.NoError(t, err)
+		proof, err := holder.ProveEquality()
+		require.NoError(t, err)
+
+		key, err := keys.GeneratePrivateKey(curveA)
+		require.NoError(t, err)
+		adaptor, err := other.CreateAdaptor(key, msg, proof)
+		require.NoError(t, err)
+
+		// the secret holder walks away without completing the signature
+		require.NoError(t, holder.Refund())
+		_, err = holder.CompleteAndReveal(key.Public(), msg, adaptor)
+		require.ErrorIs(t, err, ErrRefunded)
+		_, err = holder.ProveEquality()
+		require.ErrorIs(t, err, ErrRefunded)
+		require.ErrorIs(t, holder.Refund(), ErrRefunded)
+
+		// without the completed signature, the secret can't be recovered
+		_, err = other.RecoverCounterpartySecret(&Signature{R: adaptor.R, S: adaptor.S})
+		require.Error(t, err)
+
+		require.NoError(t, other.Refund())
+		_, err = other.RecoverCounterpartySecret(&Signature{R: adaptor.R, S: adaptor.S})
+		require.ErrorIs(t, err, ErrRefunded)
+		_, err = other.CreateAdaptor(key, msg, proof)
+		require.ErrorIs(t, err, ErrRefunded)
+	}
+}
+
+func TestSession_RejectsInvalidInput(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	msg := []byte("claim transaction")
+
+	holder := NewSession(curveA, curveB)
+	_, err := holder.ProveEquality()
+	require.Error(t, err)
+	pointA, _, err := holder.CommitSecret()
+	require.NoError(t, err)
+	proof, err := holder.ProveEquality()
+	require.NoError(t, err)
+
+	key, err := keys.GeneratePrivateKey(curveA)
+	require.NoError(t, err)
+
+	// a proof for other points is rejected
+	forged := *proof
+	forged.CommitmentA = curveA.ScalarBaseMul(curveA.NewRandomScalar())
+	_, err = NewSession(curveA, curveB).CreateAdaptor(key, msg, &forged)
+	require.Error(t, err)
+
+	// so is a key on curve B
+	keyB, err := keys.GeneratePrivateKey(curveB)
+	require.NoError(t, err)
+	_, err = NewSession(curveA, curveB).CreateAdaptor(keyB, msg, proof)
+	require.Error(t, err)
+
+	// a pre-signature encrypted to another point isn't completed, as that
+	// would leak the secret
+	other, err := NewAdaptorSignature(key, curveA.ScalarBaseMul(curveA.NewRandomScalar()), msg)
+	require.NoError(t, err)
+	require.False(t, other.Verify(key.Public(), pointA, msg))
+	_, err = holder.CompleteAndReveal(key.Public(), msg, other)
+	require.Error(t, err)
+
+	// as is one over another message
+	adaptor, err := NewAdaptorSignature(key, pointA, msg)
+	require.NoError(t, err)
+	_, err = holder.CompleteAndReveal(key.Public(), []byte("another transaction"), adaptor)
+	require.Error(t, err)
+	require.NoError(t, holder.Refund())
+}
+
+// BenchmarkSwapEndToEnd measures a whole swap between secp256k1 and
+// ed25519: committing to the secret, proving and verifying the DLEQ proof,
+// creating, verifying and completing the adaptor signature and recovering
+// the secret.
+func BenchmarkSwapEndToEnd(b *testing.B) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	msg := []byte("claim transaction")
+
+	key, err := keys.GeneratePrivateKey(curveA)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		holder := NewSession(curveA, curveB)
+		other := NewSession(curveA, curveB)
+
+		_, _, err := holder.CommitSecret()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		proof, err := holder.ProveEquality()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		// CreateAdaptor verifies the proof, and CompleteAndReveal the
+		// adaptor signature
+		adaptor, err := other.CreateAdaptor(key, msg, proof)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		sig, err := holder.CompleteAndReveal(key.Public(), msg, adaptor)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		_, err = other.RecoverCounterpartySecret(sig)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package dleq
+
+import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"errors"
+)
+
+// symmetricKeyDomain is the HKDF salt of DeriveSymmetricKey, so its keys
+// are unrelated to anything else derived from the same secret.
+const symmetricKeyDomain = "go-dleq/symmetric-key/v1"
+
+// DeriveSymmetricKey derives a length-byte symmetric key from a secret
+// shared across the curves, eg. one recovered once both parties have
+// agreed on it, with HKDF-SHA256 (RFC 5869). The salt is a fixed domain
+// tag and info binds the key to its use, eg. "myprotocol/v1 encryption",
+// so different info gives independent keys. length must be in
+// [1, 255*32].
+func DeriveSymmetricKey(secret [32]byte, info []byte, length int) ([]byte, error) {
+	if length < 1 || length > 255*sha256.Size {
+		return nil, errors.New("invalid symmetric key length")
+	}
+
+	return hkdf.Key(sha256.New, secret[:], []byte(symmetricKeyDomain), string(info), length)
+}
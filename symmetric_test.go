@@ -0,0 +1,51 @@
+package dleq
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveSymmetricKey(t *testing.T) {
+	var secret [32]byte
+	for i := range secret {
+		secret[i] = byte(i + 1)
+	}
+
+	// HKDF-SHA256 with the domain tag as salt, computed independently
+	vectors := []struct {
+		info     string
+		length   int
+		expected string
+	}{
+		{"swap session 1", 32, "e13bc5d589d2537e4809d18ec59aa727b62a58d0b7018aaec504c164605a7d8b"},
+		{"", 42, "cdb1fc3bd9a79da6d5caae370e4bc6844672ec622c3199efa722850a067fec1f7bca2531a112c8162d2f"},
+	}
+	for _, v := range vectors {
+		key, err := DeriveSymmetricKey(secret, []byte(v.info), v.length)
+		require.NoError(t, err)
+		require.Equal(t, v.expected, hex.EncodeToString(key))
+	}
+
+	keyA, err := DeriveSymmetricKey(secret, []byte("swap session 1"), 32)
+	require.NoError(t, err)
+	keyB, err := DeriveSymmetricKey(secret, []byte("swap session 2"), 32)
+	require.NoError(t, err)
+	require.NotEqual(t, keyA, keyB)
+
+	other := secret
+	other[0] ^= 1
+	keyC, err := DeriveSymmetricKey(other, []byte("swap session 1"), 32)
+	require.NoError(t, err)
+	require.NotEqual(t, keyA, keyC)
+
+	for _, length := range []int{-1, 0, 255*32 + 1} {
+		_, err = DeriveSymmetricKey(secret, nil, length)
+		require.Error(t, err)
+	}
+
+	key, err := DeriveSymmetricKey(secret, nil, 255*32)
+	require.NoError(t, err)
+	require.Len(t, key, 255*32)
+}
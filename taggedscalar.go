@@ -0,0 +1,75 @@
+package dleq
+
+import (
+	"errors"
+	"fmt"
+)
+
+// TaggedScalar is a scalar together with the curve whose group order it's
+// reduced by. Its binary encoding records the curve, so a scalar can't be
+// decoded as one of another curve by mistake, eg. a big-endian secp256k1
+// scalar read as a little-endian ed25519 one.
+type TaggedScalar struct {
+	Curve  Curve
+	Scalar Scalar
+}
+
+// MarshalBinary encodes the scalar as the curve's identifier followed by
+// the scalar's encoding. The curve must be registered.
+func (t *TaggedScalar) MarshalBinary() ([]byte, error) {
+	if t.Curve == nil || t.Scalar == nil {
+		return nil, errors.New("tagged scalar has no curve or scalar")
+	}
+
+	id, err := curveID(t.Curve)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{id}, t.Scalar.Normalize().Encode()...), nil
+}
+
+// UnmarshalBinary decodes a scalar encoded by MarshalBinary, for the curve
+// registered with the encoded identifier. It rejects scalars that aren't
+// below that curve's group order.
+func (t *TaggedScalar) UnmarshalBinary(in []byte) error {
+	if len(in) < 1 {
+		return errInputBytesTooShort
+	}
+
+	curve, err := CurveByID(in[0])
+	if err != nil {
+		return err
+	}
+
+	if len(in) != 1+curve.ScalarSize() {
+		return fmt.Errorf("invalid tagged scalar length %d for %s", len(in), curve.Name())
+	}
+
+	s, err := curve.DecodeToScalar(in[1:])
+	if err != nil {
+		return fmt.Errorf("invalid scalar for %s: %w", curve.Name(), err)
+	}
+
+	*t = TaggedScalar{
+		Curve:  curve,
+		Scalar: s,
+	}
+	return nil
+}
+
+// ScalarFor returns the scalar if it's tagged with the given curve, and an
+// error otherwise. Decoding with UnmarshalBinary and then calling ScalarFor
+// with the curve the caller expects rejects a scalar of any other curve,
+// including one that happens to be in range for both.
+func (t *TaggedScalar) ScalarFor(curve Curve) (Scalar, error) {
+	if t.Curve == nil || t.Curve.Name() != curve.Name() {
+		name := "no curve"
+		if t.Curve != nil {
+			name = t.Curve.Name()
+		}
+		return nil, fmt.Errorf("scalar is for %s, expected %s", name, curve.Name())
+	}
+
+	return t.Scalar, nil
+}
@@ -0,0 +1,63 @@
+package dleq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+func TestTaggedScalar_RoundTrip(t *testing.T) {
+	for _, curve := range allCurves() {
+		s := curve.NewRandomScalar()
+		enc, err := (&TaggedScalar{Curve: curve, Scalar: s}).MarshalBinary()
+		require.NoError(t, err)
+		require.Len(t, enc, 1+curve.ScalarSize())
+
+		decoded := new(TaggedScalar)
+		require.NoError(t, decoded.UnmarshalBinary(enc))
+		require.Equal(t, curve.Name(), decoded.Curve.Name())
+		require.True(t, decoded.Scalar.Eq(s))
+
+		got, err := decoded.ScalarFor(curve)
+		require.NoError(t, err)
+		require.True(t, got.Eq(s))
+	}
+}
+
+func TestTaggedScalar_RejectsWrongCurve(t *testing.T) {
+	secp := secp256k1.NewCurve()
+	ed := ed25519.NewCurve()
+
+	// n - 1 on secp256k1 is far above the ed25519 group order, so relabeling
+	// its encoding as an ed25519 scalar fails to decode
+	enc, err := (&TaggedScalar{Curve: secp, Scalar: secp.OrderMinusOne()}).MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, new(TaggedScalar).UnmarshalBinary(enc))
+	enc[0] = CurveIDEd25519
+	require.Error(t, new(TaggedScalar).UnmarshalBinary(enc))
+
+	// 1 on secp256k1 is 2^248 when read as an ed25519 scalar, which is in
+	// range, but the tag still keeps it from being used as one
+	enc, err = (&TaggedScalar{Curve: secp, Scalar: secp.ScalarFromInt(1)}).MarshalBinary()
+	require.NoError(t, err)
+	decoded := new(TaggedScalar)
+	require.NoError(t, decoded.UnmarshalBinary(enc))
+	_, err = decoded.ScalarFor(ed)
+	require.Error(t, err)
+	_, err = ed.DecodeToScalar(enc[1:])
+	require.NoError(t, err)
+
+	// unknown identifiers and wrong lengths
+	bad := append([]byte{}, enc...)
+	bad[0] = 0xff
+	require.Error(t, new(TaggedScalar).UnmarshalBinary(bad))
+	require.ErrorIs(t, new(TaggedScalar).UnmarshalBinary(nil), errInputBytesTooShort)
+	require.Error(t, new(TaggedScalar).UnmarshalBinary(enc[:len(enc)-1]))
+	require.Error(t, new(TaggedScalar).UnmarshalBinary(append(enc, 0)))
+
+	_, err = new(TaggedScalar).MarshalBinary()
+	require.Error(t, err)
+}
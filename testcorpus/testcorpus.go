@@ -0,0 +1,326 @@
+//go:build testcorpus
+// +build testcorpus
+
+// Package testcorpus generates malformed inputs for the decode functions of
+// go-dleq: points off the curve or encoded non-canonically, scalars of the
+// group order or more, DER signatures with bad lengths and truncated or
+// corrupted proofs. Each input names the decode function that must reject
+// it, for table-driven tests and as fuzz seeds.
+//
+// The package is only built with the testcorpus tag:
+//
+//	go test -tags testcorpus ./...
+package testcorpus
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+)
+
+// Target is the decode function an input is meant for.
+type Target int
+
+const (
+	// Point inputs are rejected by DecodeToPoint of the curve, or by
+	// ValidatePoint of the decoded point.
+	Point Target = iota
+	// Scalar inputs are rejected by DecodeToScalar of the curve.
+	Scalar
+	// DERSignature inputs are rejected by secp256k1.DERToCompact.
+	DERSignature
+	// Proof inputs are rejected by Proof.Deserialize with secp256k1 as
+	// curve A and ed25519 as curve B.
+	Proof
+)
+
+func (t Target) String() string {
+	switch t {
+	case Point:
+		return "point"
+	case Scalar:
+		return "scalar"
+	case DERSignature:
+		return "DER signature"
+	case Proof:
+		return "proof"
+	default:
+		return "unknown"
+	}
+}
+
+// Names of the curves, as returned by Curve.Name.
+const (
+	Secp256k1 = "secp256k1"
+	Ed25519   = "ed25519"
+)
+
+// Input is a malformed input.
+type Input struct {
+	// Name describes what's wrong with the input. Names are unique.
+	Name   string
+	Target Target
+	// Curve is the curve of a Point or Scalar input, Secp256k1 or Ed25519,
+	// and empty otherwise.
+	Curve string
+	Data  []byte
+}
+
+// Inputs returns the whole corpus. The inputs are built on every call, so
+// callers may modify them.
+func Inputs() []Input {
+	var c corpus
+	c.secp256k1Points()
+	c.ed25519Points()
+	c.scalars()
+	c.derSignatures()
+	c.proofs()
+	return c.inputs
+}
+
+// Data returns the data of the inputs for the given target and curve, eg.
+// to seed a fuzz test. curve is ignored for DERSignature and Proof.
+func Data(target Target, curve string) [][]byte {
+	var data [][]byte
+	for _, in := range Inputs() {
+		if in.Target != target {
+			continue
+		}
+
+		if target == DERSignature || target == Proof || in.Curve == curve {
+			data = append(data, in.Data)
+		}
+	}
+
+	return data
+}
+
+var (
+	secpP  = mustHex("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+	secpN  = mustHex("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141")
+	secpGx = mustHex("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	secpGy = mustHex("483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+
+	// the ed25519 field prime 2^255 - 19 and group order L, and the base
+	// point's encoding, from RFC 8032
+	edP    = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+	edL    = mustBig("7237005577332262213973186563042994240857116359379907606001950938285454250989")
+	edBase = mustHex("5866666666666666666666666666666666666666666666666666666666666666")
+)
+
+type corpus struct {
+	inputs []Input
+}
+
+func (c *corpus) add(target Target, curve, name string, data []byte) {
+	prefix := target.String()
+	if curve != "" {
+		prefix = curve + " " + prefix
+	}
+
+	c.inputs = append(c.inputs, Input{
+		Name:   prefix + ": " + name,
+		Target: target,
+		Curve:  curve,
+		Data:   data,
+	})
+}
+
+func (c *corpus) secp256k1Points() {
+	add := func(name string, data ...[]byte) {
+		c.add(Point, Secp256k1, name, bytes.Join(data, nil))
+	}
+
+	add("empty")
+	add("short", []byte{0x02}, secpGx[:31])
+	add("long", []byte{0x02}, secpGx, []byte{0})
+	add("uncompressed", []byte{0x04}, secpGx, secpGy)
+	add("uncompressed prefix", []byte{0x04}, secpGx)
+	add("hybrid prefix", []byte{0x06}, secpGx)
+	add("infinity", make([]byte, 33))
+	add("x = p", []byte{0x02}, secpP)
+	add("x = p + 1", []byte{0x03}, be32(new(big.Int).Add(new(big.Int).SetBytes(secpP), big.NewInt(1))))
+	add("x = 2^256 - 1", []byte{0x02}, bytes.Repeat([]byte{0xff}, 32))
+
+	// x^3 + 7 has no square root, so there's no point with this x
+	p := new(big.Int).SetBytes(secpP)
+	x := notOnCurve(p, func(x *big.Int) *big.Int {
+		y2 := new(big.Int).Exp(x, big.NewInt(3), p)
+		return y2.Add(y2, big.NewInt(7))
+	})
+	add("x off the curve", []byte{0x02}, be32(x))
+	add("x off the curve, odd y", []byte{0x03}, be32(x))
+}
+
+func (c *corpus) ed25519Points() {
+	add := func(name string, data []byte) {
+		c.add(Point, Ed25519, name, data)
+	}
+
+	add("empty", nil)
+	add("short", edBase[:31])
+	add("long", append(bytes.Clone(edBase), 0))
+
+	// y of p or more is a non-canonical encoding of y - p
+	add("y = p", le32(edP))
+	add("y = p + 1", le32(new(big.Int).Add(edP, big.NewInt(1))))
+	add("y = 2^255 - 1", le32(new(big.Int).Add(edP, big.NewInt(18))))
+
+	// x = 0 has no negative
+	negZero := le32(big.NewInt(1))
+	negZero[31] |= 0x80
+	add("negative zero x", negZero)
+
+	// x^2 = (y^2 - 1) / (d*y^2 + 1) has no square root
+	d := new(big.Int).Neg(big.NewInt(121665))
+	d.Mul(d, new(big.Int).ModInverse(big.NewInt(121666), edP))
+	y := notOnCurve(edP, func(y *big.Int) *big.Int {
+		y2 := new(big.Int).Mul(y, y)
+		u := new(big.Int).Sub(y2, big.NewInt(1))
+		v := new(big.Int).Mul(d, y2)
+		v.Add(v, big.NewInt(1)).Mod(v, edP)
+		if v.Sign() == 0 {
+			return big.NewInt(0)
+		}
+
+		return u.Mul(u, v.ModInverse(v, edP))
+	})
+	add("y off the curve", le32(y))
+
+	// points of small order, which decode but fail ValidatePoint
+	add("identity", le32(big.NewInt(1)))
+	add("order 2", le32(new(big.Int).Sub(edP, big.NewInt(1))))
+	add("order 4", make([]byte, 32))
+	add("order 8", mustHex("26e8958fc2b227b045c3f489f2ef98f0d5dfac05d3c63339b13802886d53fc05"))
+	add("order 8, negative x", mustHex("c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a"))
+}
+
+func (c *corpus) scalars() {
+	n := new(big.Int).SetBytes(secpN)
+	for _, s := range []struct {
+		curve  string
+		encode func(*big.Int) []byte
+		order  *big.Int
+	}{
+		{Secp256k1, be32, n},
+		{Ed25519, le32, edL},
+	} {
+		c.add(Scalar, s.curve, "empty", nil)
+		c.add(Scalar, s.curve, "short", make([]byte, 31))
+		c.add(Scalar, s.curve, "long", make([]byte, 33))
+		c.add(Scalar, s.curve, "order", s.encode(s.order))
+		c.add(Scalar, s.curve, "order + 1", s.encode(new(big.Int).Add(s.order, big.NewInt(1))))
+		c.add(Scalar, s.curve, "2^256 - 1", bytes.Repeat([]byte{0xff}, 32))
+	}
+
+	// 2^255 is below the secp256k1 order, but not the ed25519 order
+	c.add(Scalar, Ed25519, "2^255", le32(new(big.Int).Lsh(big.NewInt(1), 255)))
+}
+
+func (c *corpus) derSignatures() {
+	add := func(name string, data ...byte) {
+		c.add(DERSignature, "", name, data)
+	}
+
+	// the shortest valid signature is 30 06 02 01 01 02 01 01, with r = s = 1
+	add("empty")
+	add("too short", 0x30, 0x05, 0x02, 0x01, 0x01, 0x02, 0x00)
+	add("too long", append([]byte{0x30, 0x47, 0x02, 0x21, 0x00}, bytes.Repeat([]byte{0x01}, 68)...)...)
+	add("not a sequence", 0x31, 0x06, 0x02, 0x01, 0x01, 0x02, 0x01, 0x01)
+	add("sequence length too long", 0x30, 0x07, 0x02, 0x01, 0x01, 0x02, 0x01, 0x01)
+	add("sequence length too short", 0x30, 0x05, 0x02, 0x01, 0x01, 0x02, 0x01, 0x01)
+	add("r not an integer", 0x30, 0x06, 0x04, 0x01, 0x01, 0x02, 0x01, 0x01)
+	add("s not an integer", 0x30, 0x06, 0x02, 0x01, 0x01, 0x03, 0x01, 0x01)
+	add("empty r", 0x30, 0x07, 0x02, 0x00, 0x02, 0x03, 0x01, 0x01, 0x01)
+	add("r length past the end", 0x30, 0x06, 0x02, 0x09, 0x01, 0x02, 0x01, 0x01)
+	add("s length past the end", 0x30, 0x06, 0x02, 0x01, 0x01, 0x02, 0x02, 0x01)
+	add("negative r", 0x30, 0x06, 0x02, 0x01, 0x81, 0x02, 0x01, 0x01)
+	add("negative s", 0x30, 0x06, 0x02, 0x01, 0x01, 0x02, 0x01, 0xff)
+	add("r with a leading zero", 0x30, 0x07, 0x02, 0x02, 0x00, 0x01, 0x02, 0x01, 0x01)
+	add("r over 32 bytes", append(append([]byte{0x30, 0x26, 0x02, 0x21}, bytes.Repeat([]byte{0x01}, 33)...), 0x02, 0x01, 0x01)...)
+	add("trailing data", 0x30, 0x07, 0x02, 0x01, 0x01, 0x02, 0x01, 0x01, 0x00)
+}
+
+// proofs are laid out as Proof.Serialize does for secp256k1 and ed25519.
+func (c *corpus) proofs() {
+	add := func(name string, data ...[]byte) {
+		c.add(Proof, "", name, bytes.Join(data, nil))
+	}
+
+	g := append([]byte{0x02}, secpGx...)
+	if secpGy[31]&1 == 1 {
+		g[0] = 0x03
+	}
+
+	one := be32(big.NewInt(1))
+	edOne := le32(big.NewInt(1))
+	bitProof := func(commitmentA, commitmentB, scalarA, scalarB []byte) []byte {
+		// commitments, then the challenges, a0, a1, b0 and b1
+		return bytes.Join([][]byte{
+			commitmentA, commitmentB,
+			scalarA, scalarB,
+			scalarA, scalarA,
+			scalarB, scalarB,
+		}, nil)
+	}
+
+	add("empty")
+	add("truncated commitment", g[:10])
+	add("missing bit count", g, edBase)
+	add("invalid commitment A", []byte{0x02}, secpP, edBase, []byte{0, 0, 0})
+	add("invalid commitment B", g, le32(new(big.Int).Add(edP, big.NewInt(1))), []byte{0, 0, 0})
+	add("bit count past the end", g, edBase, []byte{255}, bitProof(g, edBase, one, edOne))
+	add("truncated bit proof", g, edBase, []byte{1}, bitProof(g, edBase, one, edOne)[:100])
+	add("bit commitment off the curve", g, edBase, []byte{1}, bitProof(append([]byte{0x05}, secpGx...), edBase, one, edOne), []byte{0, 0})
+	add("bit commitment not canonical", g, edBase, []byte{1}, bitProof(g, le32(edP), one, edOne), []byte{0, 0})
+	add("scalar A of the order", g, edBase, []byte{1}, bitProof(g, edBase, secpN, edOne), []byte{0, 0})
+	add("scalar B of the order", g, edBase, []byte{1}, bitProof(g, edBase, one, le32(edL)), []byte{0, 0})
+	add("missing signatures", g, edBase, []byte{0})
+	add("signature A past the end", g, edBase, []byte{0, 64}, make([]byte, 10))
+	add("signature B past the end", g, edBase, []byte{0, 0, 64}, make([]byte, 10))
+}
+
+// notOnCurve returns the smallest x of at least 2 for which f(x), the
+// square of the other coordinate, has no square root modulo p.
+func notOnCurve(p *big.Int, f func(x *big.Int) *big.Int) *big.Int {
+	for x := big.NewInt(2); ; x.Add(x, big.NewInt(1)) {
+		y2 := f(x)
+		y2.Mod(y2, p)
+		if y2.Sign() != 0 && big.Jacobi(y2, p) == -1 {
+			return x
+		}
+	}
+}
+
+// be32 returns x as 32 bytes big-endian.
+func be32(x *big.Int) []byte {
+	return x.FillBytes(make([]byte, 32))
+}
+
+// le32 returns x as 32 bytes little-endian.
+func le32(x *big.Int) []byte {
+	b := be32(x)
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+
+	return b
+}
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return b
+}
+
+func mustBig(s string) *big.Int {
+	x, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid integer " + s)
+	}
+
+	return x
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Domain separators of the hashes used by AggregateKeys.
+const (
+	keyListDomain        = "go-dleq/key-aggregation/list/v1"
+	keyCoefficientDomain = "go-dleq/key-aggregation/coefficient/v1"
+)
+
+// AggregateKeys aggregates public keys into a single key as in MuSig: each
+// key P_i gets the coefficient a_i = H(L, P_i), where L is the hash of all
+// the keys sorted by their encoding, and the aggregate is the sum of
+// a_i * P_i. The coefficients are returned in the order of keys. Since L
+// depends only on the set of keys, the aggregate doesn't depend on their
+// order. Every key must be a valid non-identity point.
+func AggregateKeys(curve Curve, keys []Point) (Point, []Scalar, error) {
+	if len(keys) == 0 {
+		return nil, nil, errors.New("no keys to aggregate")
+	}
+
+	for i, p := range keys {
+		err := curve.ValidatePoint(p)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid key at index %d: %w", i, err)
+		}
+	}
+
+	sorted := slices.Clone(keys)
+	slices.SortFunc(sorted, func(a, b Point) int {
+		return bytes.Compare(a.Encode(), b.Encode())
+	})
+
+	l, err := HashPointsToScalar(curve, []byte(keyListDomain), sorted...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prefix := append([]byte(keyCoefficientDomain), l.Encode()...)
+	coeffs := make([]Scalar, len(keys))
+	for i, p := range keys {
+		coeffs[i], err = HashPointsToScalar(curve, prefix, p)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	agg, err := MultiScalarMul(curve, coeffs, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return agg, coeffs, nil
+}
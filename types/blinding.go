@@ -0,0 +1,33 @@
+package types
+
+import "io"
+
+// ScalarMulBlinded returns s*p, as c.ScalarMul does, with the scalar and the
+// point blinded by randomness read from r, as a countermeasure against side
+// channels leaking s. The point is offset by a random R = k*G and the
+// scalar split into random shares s1 + s2 = s, and the result is computed
+// as s1*(p + R) + s2*(p + R) - (s*k)*G, so none of the multiplications
+// sees s or p itself.
+func ScalarMulBlinded(c Curve, s Scalar, p Point, r io.Reader) (Point, error) {
+	order := c.Order()
+	k, err := SampleScalarBelow(r, c, order)
+	if err != nil {
+		return nil, err
+	}
+	defer k.Zeroize()
+
+	s1, err := SampleScalarBelow(r, c, order)
+	if err != nil {
+		return nil, err
+	}
+	defer s1.Zeroize()
+
+	s2 := s.Sub(s1)
+	defer s2.Zeroize()
+	sk := s.Mul(k)
+	defer sk.Zeroize()
+
+	q := p.Add(c.ScalarBaseMul(k))
+	result := c.ScalarMul(s1, q).Add(c.ScalarMul(s2, q))
+	return c.SubBaseMul(result, sk), nil
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// maxNonZeroHashAttempts bounds HashToNonZeroScalar. A hash is zero with
+// probability about 2^-252, so running out means the hash is broken.
+const maxNonZeroHashAttempts = 256
+
+// ChallengeBuilder lays out the preimage of a Fiat-Shamir challenge, eg.
+// msg || L || R, in a buffer that's reused across challenges, so that
+// computing a challenge doesn't allocate once the buffer has grown to size.
+// The zero value is ready to use.
+type ChallengeBuilder struct {
+	buf []byte
+}
+
+// Reset clears the preimage, keeping the buffer for reuse.
+func (b *ChallengeBuilder) Reset() {
+	b.buf = b.buf[:0]
+}
+
+// AddMessage appends msg to the preimage.
+func (b *ChallengeBuilder) AddMessage(msg []byte) {
+	b.buf = append(b.buf, msg...)
+}
+
+// AddPoint appends the compressed encoding of p to the preimage. It's
+// written in place if p implements PointEncodeInto and the buffer has room,
+// otherwise the buffer grows to fit it.
+func (b *ChallengeBuilder) AddPoint(p Point) {
+	if into, ok := p.(PointEncodeInto); ok {
+		n, err := into.EncodeInto(b.buf[len(b.buf):cap(b.buf)])
+		if err == nil {
+			b.buf = b.buf[:len(b.buf)+n]
+			return
+		}
+	}
+
+	b.buf = append(b.buf, p.Encode()...)
+}
+
+// Bytes returns the preimage. It's only valid until the builder is next
+// modified.
+func (b *ChallengeBuilder) Bytes() []byte {
+	return b.buf
+}
+
+// Challenge hashes the preimage to a scalar on the given curve.
+func (b *ChallengeBuilder) Challenge(curve Curve) (Scalar, error) {
+	return curve.HashToScalar(b.buf)
+}
+
+// HashPointsToScalar hashes msg followed by the compressed encodings of the
+// points to a scalar on the given curve, as HashToScalar on their
+// concatenation would. The preimage is laid out in a single buffer sized up
+// front.
+func HashPointsToScalar(curve Curve, msg []byte, points ...Point) (Scalar, error) {
+	b := ChallengeBuilder{
+		buf: make([]byte, 0, len(msg)+len(points)*curve.CompressedPointSize()),
+	}
+
+	b.AddMessage(msg)
+	for _, p := range points {
+		b.AddPoint(p)
+	}
+
+	return b.Challenge(curve)
+}
+
+// HashToNonZeroScalar hashes in to a nonzero scalar on the given curve, eg.
+// for a blinding factor, which must not be zero. It returns HashToScalar of
+// in unless that's zero, in which case it hashes in followed by a 32-bit
+// big-endian counter, starting from 1, until the result is nonzero.
+func HashToNonZeroScalar(curve Curve, in []byte) (Scalar, error) {
+	preimage := in
+	var retry []byte
+	for counter := uint32(1); counter <= maxNonZeroHashAttempts; counter++ {
+		s, err := curve.HashToScalar(preimage)
+		if err != nil {
+			return nil, err
+		}
+
+		if !s.IsZero() {
+			return s, nil
+		}
+
+		if retry == nil {
+			retry = make([]byte, len(in)+4)
+			copy(retry, in)
+		}
+
+		binary.BigEndian.PutUint32(retry[len(in):], counter)
+		preimage = retry
+	}
+
+	return nil, errors.New("hash to nonzero scalar failed")
+}
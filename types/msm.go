@@ -0,0 +1,157 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// maxWindowBits bounds MultiScalarMulConfig.WindowBits; each window needs
+// 2^WindowBits - 1 buckets.
+const maxWindowBits = 16
+
+// multiScalarMuler is implemented by curves with a faster multi-scalar
+// multiplication than the generic one, eg. one that doesn't normalize every
+// intermediate point.
+type multiScalarMuler interface {
+	MultiScalarMul(scalars []Scalar, points []Point, windowBits int) Point
+}
+
+// MultiScalarMulConfig tunes MultiScalarMulWithConfig.
+type MultiScalarMulConfig struct {
+	// WindowBits is the number of scalar bits processed per window. Larger
+	// windows need fewer point doublings but more buckets. Zero selects a
+	// default based on the number of points, see DefaultWindowBits.
+	WindowBits int
+}
+
+// DefaultWindowBits returns the window size used by MultiScalarMul for n
+// points. The cost of a window is about n additions to fill the buckets and
+// 2^w additions to sum them, so the best window grows with log2(n).
+func DefaultWindowBits(n int) int {
+	w := bits.Len(uint(n)) - 3
+	return min(max(w, 1), maxWindowBits)
+}
+
+// MultiScalarMul returns the sum of scalars[i]*points[i] on the curve,
+// using Pippenger's bucket method with a window size chosen for the number
+// of points. It's variable-time, so it must only be used with public
+// scalars, eg. when verifying.
+func MultiScalarMul(c Curve, scalars []Scalar, points []Point) (Point, error) {
+	return MultiScalarMulWithConfig(c, scalars, points, MultiScalarMulConfig{})
+}
+
+// MultiScalarMulWithConfig is MultiScalarMul with the given configuration.
+func MultiScalarMulWithConfig(c Curve, scalars []Scalar, points []Point, cfg MultiScalarMulConfig) (Point, error) {
+	if len(scalars) != len(points) {
+		return nil, errors.New("number of scalars and points must be equal")
+	}
+
+	w := cfg.WindowBits
+	if w == 0 {
+		w = DefaultWindowBits(len(points))
+	}
+
+	if w < 1 || w > maxWindowBits {
+		return nil, fmt.Errorf("window size must be between 1 and %d bits", maxWindowBits)
+	}
+
+	if m, ok := c.(multiScalarMuler); ok {
+		return m.MultiScalarMul(scalars, points, w), nil
+	}
+
+	digits := make([][]byte, len(scalars))
+	for i, s := range scalars {
+		digits[i] = littleEndianBytes(c, s)
+	}
+
+	numBits := c.Order().BitLen()
+	numWindows := (numBits + w - 1) / w
+	buckets := make([]Point, 1<<w-1)
+
+	var acc Point
+	for window := numWindows - 1; window >= 0; window-- {
+		if acc != nil {
+			for i := 0; i < w; i++ {
+				acc = acc.Add(acc)
+			}
+		}
+
+		clear(buckets)
+		for i, p := range points {
+			d := windowDigit(digits[i], window*w, w)
+			if d == 0 {
+				continue
+			}
+
+			if buckets[d-1] == nil {
+				buckets[d-1] = p
+			} else {
+				buckets[d-1] = buckets[d-1].Add(p)
+			}
+		}
+
+		// sum of d * buckets[d-1], as a running sum of the buckets from the
+		// top, each added to the total once per bucket below it
+		var running, total Point
+		for d := len(buckets) - 1; d >= 0; d-- {
+			if buckets[d] != nil {
+				running = addOrSet(running, buckets[d])
+			}
+
+			if running != nil {
+				total = addOrSet(total, running)
+			}
+		}
+
+		if total != nil {
+			acc = addOrSet(acc, total)
+		}
+	}
+
+	if acc == nil {
+		return c.Identity(), nil
+	}
+
+	return acc, nil
+}
+
+func addOrSet(sum, p Point) Point {
+	if sum == nil {
+		return p
+	}
+
+	return sum.Add(p)
+}
+
+// littleEndianBytes returns the encoding of s in little-endian. Curves
+// encode scalars in either byte order, which is detected from the encoding
+// of one.
+func littleEndianBytes(c Curve, s Scalar) []byte {
+	enc := s.Encode()
+	one := c.ScalarFromInt(1).Encode()
+	if one[0] == 1 {
+		return enc
+	}
+
+	for i, j := 0, len(enc)-1; i < j; i, j = i+1, j-1 {
+		enc[i], enc[j] = enc[j], enc[i]
+	}
+	return enc
+}
+
+// windowDigit returns the w bits of the little-endian integer le starting
+// at bit offset.
+func windowDigit(le []byte, offset, w int) int {
+	d := 0
+	for i := 0; i < w; i++ {
+		bit := offset + i
+		if bit/8 >= len(le) {
+			break
+		}
+
+		d |= int(le[bit/8]>>(bit%8)&1) << i
+	}
+
+	return d
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// CurvesEqual reports whether a and b are the same curve with the same
+// generators: they have the same name and order, and their BasePoint and
+// AltBasePoint have the same encodings. It implements Curve.Equal.
+func CurvesEqual(a, b Curve) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Name() == b.Name() &&
+		a.Order().Cmp(b.Order()) == 0 &&
+		bytes.Equal(a.BasePoint().Encode(), b.BasePoint().Encode()) &&
+		bytes.Equal(a.AltBasePoint().Encode(), b.AltBasePoint().Encode())
+}
+
+// pointSummer is implemented by curves that can sum many points without
+// allocating and normalizing an intermediate point for every addition.
+type pointSummer interface {
+	SumPoints(points ...Point) Point
+}
+
+// SumPoints returns the sum of the given points on the curve. The sum starts
+// from the identity, so no points gives the identity.
+func SumPoints(c Curve, points ...Point) Point {
+	if s, ok := c.(pointSummer); ok {
+		return s.SumPoints(points...)
+	}
+
+	sum := c.Identity()
+	for _, p := range points {
+		sum = sum.Add(p)
+	}
+
+	return sum
+}
+
+// PointDecodeError is returned by DecodeToPoints for the first point of a
+// batch that fails to decode or validate.
+type PointDecodeError struct {
+	// Index is the position of the invalid point in the batch.
+	Index int
+	Err   error
+}
+
+func (e *PointDecodeError) Error() string {
+	return fmt.Sprintf("invalid point at index %d: %v", e.Index, e.Err)
+}
+
+func (e *PointDecodeError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeToPoints decodes count consecutive compressed points from in, which
+// must be exactly count points long, with DecodeToPoint and validates each
+// with ValidatePoint. It implements Curve.DecodeToPoints for curves that
+// can't do better than decoding the points one at a time.
+func DecodeToPoints(c Curve, in []byte, count int) ([]Point, error) {
+	size := c.CompressedPointSize()
+	if count < 0 || len(in) != count*size {
+		return nil, fmt.Errorf("invalid length %d for %d points", len(in), count)
+	}
+
+	points := make([]Point, count)
+	for i := range points {
+		p, err := c.DecodeToPoint(in[i*size : (i+1)*size])
+		if err == nil {
+			err = c.ValidatePoint(p)
+		}
+
+		if err != nil {
+			return nil, &PointDecodeError{Index: i, Err: err}
+		}
+
+		points[i] = p
+	}
+
+	return points, nil
+}
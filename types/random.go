@@ -0,0 +1,49 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// SampleScalarBelow returns a uniformly random scalar in [1, bound) using
+// randomness read from r. Candidates are drawn with the bit length of bound
+// and rejected if they fall outside the range, so the output is unbiased.
+//
+// bound must be greater than 1 and no larger than the curve order.
+func SampleScalarBelow(r io.Reader, c Curve, bound *big.Int) (Scalar, error) {
+	if bound == nil || bound.Cmp(big.NewInt(1)) <= 0 {
+		return nil, errors.New("bound must be greater than 1")
+	}
+
+	if bound.Cmp(c.Order()) > 0 {
+		return nil, errors.New("bound must not exceed the curve order")
+	}
+
+	bitLen := bound.BitLen()
+	buf := make([]byte, (bitLen+7)/8)
+	mask := byte(0xff >> (len(buf)*8 - bitLen))
+
+	v := new(big.Int)
+	for {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, fmt.Errorf("failed to read randomness: %w", err)
+		}
+
+		buf[0] &= mask
+		v.SetBytes(buf)
+		if v.Sign() != 0 && v.Cmp(bound) < 0 {
+			break
+		}
+	}
+
+	// ScalarFromBytes takes little-endian bytes
+	var le [32]byte
+	v.FillBytes(le[:])
+	for i, j := 0, len(le)-1; i < j; i, j = i+1, j-1 {
+		le[i], le[j] = le[j], le[i]
+	}
+
+	return c.ScalarFromBytes(le), nil
+}
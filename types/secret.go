@@ -0,0 +1,39 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+)
+
+// weakSecretBits bounds the secrets considered trivially weak: a discrete
+// log x with x or -x below 2^weakSecretBits is found by a baby-step
+// giant-step search in seconds.
+const weakSecretBits = 32
+
+// ValidateSecretStrength checks that the little-endian secret, as taken by
+// ScalarFromBytes, is usable as a private key on c: it must be neither zero
+// nor the group order or more, and neither it nor its negation may be below
+// 2^32, which includes 1 and -1. It implements Curve.ValidateSecretStrength
+// for curves that have no faster way.
+func ValidateSecretStrength(c Curve, secret [32]byte) error {
+	be := secret
+	slices.Reverse(be[:])
+	x := new(big.Int).SetBytes(be[:])
+	defer x.SetInt64(0)
+
+	if x.Sign() == 0 {
+		return errors.New("secret is zero")
+	}
+
+	order := c.Order()
+	if x.Cmp(order) >= 0 {
+		return errors.New("secret is not below the group order")
+	}
+
+	if x.BitLen() <= weakSecretBits || new(big.Int).Sub(order, x).BitLen() <= weakSecretBits {
+		return errors.New("secret is trivially weak; it or its negation is below 2^32")
+	}
+
+	return nil
+}
@@ -1,34 +1,183 @@
 package types
 
+import (
+	"errors"
+	"math/big"
+)
+
+// ErrZeroInverse is returned when inverting a zero scalar.
+var ErrZeroInverse = errors.New("zero scalar has no inverse")
+
+// ErrBufferTooSmall is returned by EncodeInto when the destination can't
+// hold the encoding.
+var ErrBufferTooSmall = errors.New("destination buffer too small")
+
 type Curve interface {
+	// Name returns the curve's name, eg. "secp256k1". It identifies the
+	// curve, not the backend implementing it.
+	Name() string
+
+	// Equal reports whether other is the same curve with the same
+	// generators, as compared by CurvesEqual, whether or not it's the same
+	// instance or backend.
+	Equal(other Curve) bool
+
 	BitSize() uint64
+
+	// CompressedPointSize is the length of every point encoding, as
+	// returned by Point.Encode.
 	CompressedPointSize() int
+	ScalarSize() int
+
+	// Order returns a copy of the order of the prime-order group.
+	Order() *big.Int
+
+	// OrderMinusOne returns the scalar n - 1, the largest scalar, and
+	// HalfOrder returns (n - 1) / 2, the largest "low" scalar, eg. for
+	// low-S checks.
+	OrderMinusOne() Scalar
+	HalfOrder() Scalar
+
 	BasePoint() Point
 	AltBasePoint() Point
+
+	// IsGenerator reports whether p is BasePoint (isG) or AltBasePoint
+	// (isH). Points of other curves are neither.
+	IsGenerator(p Point) (isG, isH bool)
+
+	// Identity returns the identity element of the group, ie. the point at
+	// infinity on short Weierstrass curves.
+	Identity() Point
+
 	NewRandomScalar() Scalar
+
+	// RandomScalarBelow returns a uniformly random scalar in [1, bound).
+	// It returns an error if bound is not in (1, order].
+	RandomScalarBelow(bound *big.Int) (Scalar, error)
+
 	ScalarFromInt(uint32) Scalar
 	ScalarFromBytes([32]byte) Scalar
+
+	// ValidateSecretStrength returns an error if the little-endian secret,
+	// as taken by ScalarFromBytes, is zero, not below the group order or
+	// trivially weak, see ValidateSecretStrength.
+	ValidateSecretStrength(secret [32]byte) error
+
 	HashToScalar([]byte) (Scalar, error)
+
+	// ReduceWide reduces a 64-byte integer modulo the group order. The
+	// result is uniformly distributed when the input is. The bytes are read
+	// in the curve's scalar byte order.
+	ReduceWide([64]byte) Scalar
+
+	// SplitReduce splits a 64-byte value into two 32-byte halves and
+	// reduces each modulo the group order.
+	SplitReduce([64]byte) (Scalar, Scalar)
+
 	ScalarBaseMul(Scalar) Point
+
+	// AddBaseMul returns p + s*G and SubBaseMul returns p - s*G, with s*G
+	// computed as ScalarBaseMul does.
+	AddBaseMul(p Point, s Scalar) Point
+	SubBaseMul(p Point, s Scalar) Point
+
+	// BaseMulSmall returns k*G for a small constant k, avoiding a full
+	// scalar multiplication where the backend can.
+	BaseMulSmall(k uint32) Point
+
 	ScalarMul(Scalar, Point) Point
+
+	// NewFixedBaseTable precomputes multiples of p, so that multiplying p
+	// by many scalars is faster than calling ScalarMul for each.
+	NewFixedBaseTable(p Point) FixedBaseTable
+
 	Sign(s Scalar, p Point) ([]byte, error)
 	Verify(pubkey, msgPoint Point, sig []byte) bool
 
+	// ValidatePoint returns an error if the point is not a non-identity
+	// element of this curve's prime-order subgroup.
+	ValidatePoint(Point) error
+
 	// the following two functions MUST copy the byte slice
-	// before decoding.
+	// before decoding. They reject non-canonical encodings, so a point or
+	// scalar has exactly one encoding that decodes.
 	DecodeToPoint([]byte) (Point, error)
 	DecodeToScalar([]byte) (Scalar, error)
+
+	// DecodeToPoints decodes count consecutive compressed points from in,
+	// which must be exactly count points long, and validates each as
+	// ValidatePoint does. If a point is invalid, the error is a
+	// *PointDecodeError holding the index of the first invalid point.
+	DecodeToPoints(in []byte, count int) ([]Point, error)
+
+	// DecodeToPointUnchecked decodes a compressed point like DecodeToPoint,
+	// but skips whatever validation the backend can: the encoding may be
+	// non-canonical, and on secp256k1 an x coordinate with no point on the
+	// curve gives a point off the curve rather than an error. It still
+	// rejects input of the wrong length or format.
+	//
+	// It is NOT safe for untrusted input: an invalid point breaks the
+	// soundness of anything computed with it. Use it only for encodings
+	// this process produced or already validated, eg. a cached key.
+	DecodeToPointUnchecked(in []byte) (Point, error)
+}
+
+// FixedBaseTable multiplies a fixed point, using multiples of it
+// precomputed by Curve.NewFixedBaseTable. It's safe for concurrent use.
+type FixedBaseTable interface {
+	// ScalarMul returns s times the table's point, as Curve.ScalarMul does.
+	ScalarMul(s Scalar) Point
 }
 
 type Scalar interface {
 	Add(Scalar) Scalar
+
+	// AddWithCarry is like Add, and also reports whether the sum of the
+	// two (reduced) scalars reached the curve order and was reduced.
+	AddWithCarry(Scalar) (sum Scalar, reduced bool)
+
 	Sub(Scalar) Scalar
 	Negate() Scalar
 	Mul(Scalar) Scalar
 	Inverse() Scalar
+
+	// InverseConstantTime is like Inverse, but takes the same time for
+	// every scalar, so it's safe to use on secret values. It returns
+	// ErrZeroInverse for zero.
+	InverseConstantTime() (Scalar, error)
+
+	// Div returns s * b^-1, without allocating the inverse separately. Like
+	// Inverse it may take variable time. It returns ErrZeroInverse if b is
+	// zero.
+	Div(b Scalar) (Scalar, error)
+
+	// Encode returns the scalar's 32-byte encoding in the curve's byte
+	// order: big-endian for secp256k1, as in SEC 1, and little-endian for
+	// ed25519, as in RFC 8032.
 	Encode() []byte
+
 	Eq(Scalar) bool
 	IsZero() bool
+
+	// Normalize returns the scalar's canonical representative in [0, n),
+	// so that scalars that are equal modulo n are Eq. Backends that don't
+	// reduce every input, eg. the secp256k1 Ethereum backend's
+	// ScalarFromBytes, can hold values of n or more.
+	Normalize() Scalar
+
+	// Zeroize overwrites the scalar's value in place with zero. It's used
+	// to wipe secret scalars once they're no longer needed.
+	Zeroize()
+
+	// SetUint64 sets the scalar to v in place and returns it, so a scalar
+	// can be reused rather than allocating one per value.
+	SetUint64(v uint64) Scalar
+
+	// SetBytes sets the scalar in place to b, a 32-byte integer in the
+	// curve's byte order as returned by Encode, reduced modulo the group
+	// order. It returns an error, leaving the scalar unchanged, if b is not
+	// 32 bytes long.
+	SetBytes(b []byte) error
 }
 
 type Point interface {
@@ -36,7 +185,33 @@ type Point interface {
 	Add(Point) Point
 	Sub(Point) Point
 	ScalarMul(Scalar) Point
+
+	// Encode returns the compressed encoding of the point, which is always
+	// exactly the curve's CompressedPointSize bytes, the identity included.
 	Encode() []byte
+
 	IsZero() bool
 	Equals(other Point) bool
+
+	// Normalize converts the point's internal representation to affine
+	// coordinates in place, if the backend has any other. Points returned
+	// by ScalarMul, ScalarBaseMul and the other arithmetic are already
+	// normalized, so it's a no-op for them.
+	Normalize()
+
+	// EqualsBytes reports whether the point's compressed encoding is enc.
+	// It avoids decoding enc and, where possible, allocating the point's
+	// own encoding.
+	EqualsBytes(enc []byte) bool
+}
+
+// PointEncodeInto is implemented by points that can write their compressed
+// encoding into a caller-provided buffer, for hot paths that want to avoid
+// allocating an encoding per point.
+type PointEncodeInto interface {
+	// EncodeInto writes the compressed encoding to the start of dst and
+	// returns the number of bytes written, which is the curve's
+	// CompressedPointSize. If dst is shorter than that, nothing is written
+	// and it returns 0 and ErrBufferTooSmall.
+	EncodeInto(dst []byte) (int, error)
 }
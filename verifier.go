@@ -0,0 +1,83 @@
+package dleq
+
+import (
+	"github.com/pokt-network/go-dleq/types"
+)
+
+// ProofVerifier verifies proofs for a pair of curves. It precomputes
+// fixed-base tables for each curve's BasePoint and AltBasePoint and reuses
+// them for every proof it verifies. Every bit proof multiplies AltBasePoint
+// twice; BasePoint's table serves ScalarBaseMul, AddBaseMul and SubBaseMul,
+// as the bit proofs themselves only add and subtract it. Building the
+// tables takes about as long as verifying a few dozen bits, so a verifier
+// pays off from the first proof. It's safe for concurrent use.
+type ProofVerifier struct {
+	curveA, curveB Curve
+}
+
+// NewProofVerifier returns a verifier for proofs made for the given curves.
+func NewProofVerifier(curveA, curveB Curve) *ProofVerifier {
+	return &ProofVerifier{
+		curveA: newFixedBaseCurve(curveA),
+		curveB: newFixedBaseCurve(curveB),
+	}
+}
+
+// Verify verifies the proof, as Proof.Verify does with the verifier's
+// curves.
+func (v *ProofVerifier) Verify(p *Proof) error {
+	return p.VerifyWith(new(VerifyContext), v.curveA, v.curveB)
+}
+
+// fixedBaseCurve multiplies the curve's BasePoint and AltBasePoint with
+// precomputed tables. It returns its own copies of both generators, so
+// ScalarMul recognizes them by identity without comparing points.
+type fixedBaseCurve struct {
+	Curve
+	basePoint    Point
+	baseTable    types.FixedBaseTable
+	altBasePoint Point
+	altBaseTable types.FixedBaseTable
+}
+
+func newFixedBaseCurve(curve Curve) *fixedBaseCurve {
+	g, h := curve.BasePoint(), curve.AltBasePoint()
+	return &fixedBaseCurve{
+		Curve:        curve,
+		basePoint:    g,
+		baseTable:    curve.NewFixedBaseTable(g),
+		altBasePoint: h,
+		altBaseTable: curve.NewFixedBaseTable(h),
+	}
+}
+
+func (c *fixedBaseCurve) BasePoint() Point {
+	return c.basePoint
+}
+
+func (c *fixedBaseCurve) AltBasePoint() Point {
+	return c.altBasePoint
+}
+
+func (c *fixedBaseCurve) ScalarBaseMul(s Scalar) Point {
+	return c.baseTable.ScalarMul(s)
+}
+
+func (c *fixedBaseCurve) AddBaseMul(p Point, s Scalar) Point {
+	return p.Add(c.baseTable.ScalarMul(s))
+}
+
+func (c *fixedBaseCurve) SubBaseMul(p Point, s Scalar) Point {
+	return p.Sub(c.baseTable.ScalarMul(s))
+}
+
+func (c *fixedBaseCurve) ScalarMul(s Scalar, p Point) Point {
+	switch p {
+	case c.basePoint:
+		return c.baseTable.ScalarMul(s)
+	case c.altBasePoint:
+		return c.altBaseTable.ScalarMul(s)
+	}
+
+	return c.Curve.ScalarMul(s, p)
+}
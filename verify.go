@@ -1,29 +1,302 @@
 package dleq
 
 import (
+	"bytes"
+	"crypto/sha512"
 	"errors"
 	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pokt-network/go-dleq/types"
 )
 
-// Verify verifies the proof is valid against the given curves.
+// ErrMalformedProof is returned when verifying a proof panics in the
+// curve arithmetic, eg. because it holds points or scalars of another curve.
+var ErrMalformedProof = errors.New("malformed proof")
+
+// ErrDegenerateCommitments is returned for a proof whose bit commitments
+// show that their blinders weren't random, eg. because the prover's random
+// number generator is broken: a commitment is the identity, a generator or
+// the public key, or two commitments are equal. None of these happen with
+// random blinders but for negligible probability.
+var ErrDegenerateCommitments = errors.New("degenerate bit commitments")
+
+// ErrDuplicatePublicKeys is returned by VerifyAgainst and VerifyAndExtract
+// when the public keys on both curves have the same encoding although the
+// curves or their generators differ. Honest keys x*G on different curves,
+// or with different generators, never share an encoding, so this means a
+// malformed message, eg. one presenting a single key as both. On the same
+// curve with the same generators the keys are always equal, and are
+// accepted.
+var ErrDuplicatePublicKeys = errors.New("public keys on both curves are identical")
+
+// Verify verifies the proof is valid against the given curves. It doesn't
+// panic on any proof: a panic in the curve arithmetic is returned as
+// ErrMalformedProof.
 // TODO: encode curves into proof somehow?
 func (p *Proof) Verify(curveA, curveB Curve) error {
-	commitmentsA := make([]commitment, len(p.proofs))
-	for i := range commitmentsA {
-		commitmentsA[i] = p.proofs[i].commitmentA
+	return p.VerifyWith(new(VerifyContext), curveA, curveB)
+}
+
+// VerifyContext holds scratch buffers for verification: the challenge
+// preimages and the commitments being summed. Verifying many proofs with
+// VerifyWith and the same context reuses them rather than allocating them
+// for every proof and bit. A context must only be used by one verification
+// at a time. The zero value is ready to use.
+type VerifyContext struct {
+	challenge   types.ChallengeBuilder
+	commitments []commitment
+	seen        map[[64]byte]struct{}
+
+	// metrics is only set by VerifyWithMetrics
+	metrics *VerifyMetrics
+}
+
+// VerifyWith is like Verify, using the scratch buffers of ctx.
+func (p *Proof) VerifyWith(ctx *VerifyContext, curveA, curveB Curve) error {
+	stepper := VerifyStepper{
+		curveA: curveA,
+		curveB: curveB,
+		proof:  p,
+		ctx:    ctx,
+	}
+
+	for {
+		done, err := stepper.Step()
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+	}
+}
+
+// VerifyStepper verifies a proof incrementally, one bit per call to Step, so
+// that verification can be interleaved with other work or abandoned part
+// way through. Stepping to completion is equivalent to Proof.Verify.
+type VerifyStepper struct {
+	curveA, curveB Curve
+	proof          *Proof
+	ctx            *VerifyContext
+	next           int
+	done           bool
+	err            error
+}
+
+// NewVerifyStepper returns a stepper verifying the proof against the given
+// curves.
+func NewVerifyStepper(curveA, curveB Curve, proof *Proof) *VerifyStepper {
+	return &VerifyStepper{
+		curveA: curveA,
+		curveB: curveB,
+		proof:  proof,
+		ctx:    new(VerifyContext),
+	}
+}
+
+// Step verifies the next bit of the proof. The first step also verifies
+// the commitments and signatures that don't belong to a single bit. It
+// returns done once the last bit is verified or any check fails, in which
+// case err is the failure; further calls return the same result. A panic
+// while verifying is returned as ErrMalformedProof.
+func (s *VerifyStepper) Step() (done bool, err error) {
+	if s.done {
+		return true, s.err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			s.done = true
+			s.err = fmt.Errorf("%w: %v", ErrMalformedProof, r)
+			done, err = s.done, s.err
+		}
+	}()
+
+	if s.next == 0 {
+		start := s.ctx.metrics.now()
+		err = s.ctx.verifyCommitments(s.curveA, s.curveB, s.proof)
+		s.ctx.metrics.record(phaseCommitments, start)
+	}
+
+	if err == nil {
+		start := s.ctx.metrics.now()
+		err = s.ctx.verifyBitProof(s.curveA, s.curveB, s.proof.proofs[s.next])
+		s.ctx.metrics.record(phaseBitProofs, start)
+		if err != nil {
+			err = fmt.Errorf("failed to verify bit %d: %w", s.next, err)
+		}
 	}
 
-	err := verifyCommitmentsSum(curveA, commitmentsA, p.CommitmentA)
+	s.next++
+	s.done = err != nil || s.next == len(s.proof.proofs)
+	s.err = err
+	return s.done, s.err
+}
+
+// VerifyBatchWithBudget verifies the proofs in order until the deadline,
+// checking it between the bits of each proof, and returns how many proofs
+// it verified. The proofs after those are left unverified, including one
+// interrupted part way through; verifying none before the deadline isn't an
+// error. An invalid proof stops verification with an error.
+func VerifyBatchWithBudget(curveA, curveB Curve, proofs []*Proof, deadline time.Time) (verified int, err error) {
+	return verifyBatchUntil(curveA, curveB, proofs, func() bool {
+		return !time.Now().Before(deadline)
+	})
+}
+
+// verifyBatchUntil is VerifyBatchWithBudget, checking expired instead of
+// the clock before each bit, so tests can stop it at a given bit.
+func verifyBatchUntil(curveA, curveB Curve, proofs []*Proof, expired func() bool) (verified int, err error) {
+	ctx := new(VerifyContext)
+	for i, p := range proofs {
+		stepper := VerifyStepper{
+			curveA: curveA,
+			curveB: curveB,
+			proof:  p,
+			ctx:    ctx,
+		}
+
+		for done := false; !done; {
+			if expired() {
+				return verified, nil
+			}
+
+			done, err = stepper.Step()
+			if err != nil {
+				return verified, fmt.Errorf("failed to verify proof %d: %w", i, err)
+			}
+		}
+
+		verified++
+	}
+
+	return verified, nil
+}
+
+// VerifyParallel is like Verify, verifying the bit proofs on up to workers
+// goroutines, or GOMAXPROCS if workers isn't positive. Bits are handed out
+// in order and none are started once one fails, so it returns the same
+// error as Verify: that of the first invalid bit.
+func (p *Proof) VerifyParallel(curveA, curveB Curve, workers int) error {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if workers > len(p.proofs) {
+		workers = len(p.proofs)
+	}
+
+	err := recoverMalformed(func() error {
+		return new(VerifyContext).verifyCommitments(curveA, curveB, p)
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		wg     sync.WaitGroup
+		next   atomic.Int64
+		failed atomic.Bool
+
+		mu       sync.Mutex
+		firstBit = len(p.proofs)
+		firstErr error
+	)
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := new(VerifyContext)
+			for !failed.Load() {
+				i := int(next.Add(1) - 1)
+				if i >= len(p.proofs) {
+					return
+				}
+
+				err := recoverMalformed(func() error {
+					err := ctx.verifyBitProof(curveA, curveB, p.proofs[i])
+					if err != nil {
+						return fmt.Errorf("failed to verify bit %d: %w", i, err)
+					}
+
+					return nil
+				})
+				if err == nil {
+					continue
+				}
+
+				failed.Store(true)
+				mu.Lock()
+				if i < firstBit {
+					firstBit, firstErr = i, err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	return firstErr
+}
+
+// recoverMalformed calls f, returning a panic as ErrMalformedProof like
+// VerifyStepper.Step.
+func recoverMalformed(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrMalformedProof, r)
+		}
+	}()
+
+	return f()
+}
+
+// verifyCommitments verifies that the proof has a bit proof for every bit,
+// that the bit commitments aren't degenerate and sum to the public keys and
+// the signatures by the public keys.
+func (ctx *VerifyContext) verifyCommitments(curveA, curveB Curve, p *Proof) error {
+	bits := min(curveA.BitSize(), curveB.BitSize())
+	if uint64(len(p.proofs)) != bits {
+		return fmt.Errorf("proof has %d bit proofs, expected %d", len(p.proofs), bits)
+	}
+
+	ctx.commitments = ctx.commitments[:0]
+	for i := range p.proofs {
+		ctx.commitments = append(ctx.commitments, p.proofs[i].commitmentA)
+	}
+
+	if p.CommitmentA.IsZero() || p.CommitmentB.IsZero() {
+		return errors.New("public key is the identity")
+	}
+
+	err := ctx.checkBlinding(curveA, ctx.commitments, p.CommitmentA)
+	if err != nil {
+		return fmt.Errorf("commitments on curve A: %w", err)
+	}
+
+	ctx.metrics.countScalarMuls(2 * (len(p.proofs) - 1))
+	err = verifyCommitmentsSum(curveA, ctx.commitments, p.CommitmentA)
 	if err != nil {
 		return fmt.Errorf("failed to verify commitment on curve A: %w", err)
 	}
 
-	commitmentsB := make([]commitment, len(p.proofs))
-	for i := range commitmentsB {
-		commitmentsB[i] = p.proofs[i].commitmentB
+	ctx.commitments = ctx.commitments[:0]
+	for i := range p.proofs {
+		ctx.commitments = append(ctx.commitments, p.proofs[i].commitmentB)
 	}
 
-	err = verifyCommitmentsSum(curveB, commitmentsB, p.CommitmentB)
+	err = ctx.checkBlinding(curveB, ctx.commitments, p.CommitmentB)
+	if err != nil {
+		return fmt.Errorf("commitments on curve B: %w", err)
+	}
+
+	err = verifyCommitmentsSum(curveB, ctx.commitments, p.CommitmentB)
 	if err != nil {
 		return fmt.Errorf("failed to verify commitment on curve B: %w", err)
 	}
@@ -39,73 +312,263 @@ func (p *Proof) Verify(curveA, curveB Curve) error {
 		return fmt.Errorf("failed to verify signature on commitment B")
 	}
 
-	// now calculate challenges and verify
-	bits := min(curveA.BitSize(), curveB.BitSize())
-	for i := uint64(0); i < bits; i++ {
-		proof := p.proofs[i]
-
-		aG := curveA.ScalarMul(proof.ringSig.a1, curveA.AltBasePoint())
-		eCA := proof.commitmentA.commitment.ScalarMul(proof.ringSig.eCurveA)
-
-		bH := curveB.ScalarMul(proof.ringSig.b1, curveB.AltBasePoint())
-		eCB := proof.commitmentB.commitment.ScalarMul(proof.ringSig.eCurveB)
-
-		eA1, err := hashToScalar(
-			curveA,
-			proof.commitmentA.commitment,
-			proof.commitmentB.commitment,
-			aG.Sub(eCA),
-			bH.Sub(eCB),
-		)
-		if err != nil {
-			return err
-		}
+	return nil
+}
 
-		eB1, err := hashToScalar(
-			curveB,
-			proof.commitmentA.commitment,
-			proof.commitmentB.commitment,
-			aG.Sub(eCA),
-			bH.Sub(eCB),
-		)
-		if err != nil {
-			return err
-		}
+// checkBlinding rejects bit commitments b_i*G + r_i*H that reveal a blinder
+// r_i of zero or one, by being the identity or a generator, or that are
+// equal to each other or to the public key, as only related blinders make
+// them.
+func (ctx *VerifyContext) checkBlinding(curve Curve, commitments []commitment, public Point) error {
+	if ctx.seen == nil {
+		ctx.seen = make(map[[64]byte]struct{}, len(commitments)+1)
+	}
+	clear(ctx.seen)
+	ctx.seen[pointKey(public)] = struct{}{}
 
-		commitmentAMinusOne := proof.commitmentA.commitment.Sub(curveA.BasePoint())
-		commitmentBMinusOne := proof.commitmentB.commitment.Sub(curveB.BasePoint())
-
-		aG = curveA.ScalarMul(proof.ringSig.a0, curveA.AltBasePoint())
-		bH = curveB.ScalarMul(proof.ringSig.b0, curveB.AltBasePoint())
-		ecA := commitmentAMinusOne.ScalarMul(eA1)
-		ecB := commitmentBMinusOne.ScalarMul(eB1)
-
-		eA0, err := hashToScalar(
-			curveA,
-			proof.commitmentA.commitment,
-			proof.commitmentB.commitment,
-			aG.Sub(ecA),
-			bH.Sub(ecB),
-		)
-		if err != nil {
-			return err
+	for i, c := range commitments {
+		isG, isH := curve.IsGenerator(c.commitment)
+		if c.commitment.IsZero() || isG || isH {
+			return fmt.Errorf("%w: commitment %d is the identity or a generator", ErrDegenerateCommitments, i)
 		}
 
-		eB0, err := hashToScalar(
-			curveB,
-			proof.commitmentA.commitment,
-			proof.commitmentB.commitment,
-			aG.Sub(ecA),
-			bH.Sub(ecB),
-		)
-		if err != nil {
-			return err
+		key := pointKey(c.commitment)
+		if _, ok := ctx.seen[key]; ok {
+			return fmt.Errorf("%w: commitment %d is repeated", ErrDegenerateCommitments, i)
 		}
 
-		if !eA0.Eq(proof.ringSig.eCurveA) || !eB0.Eq(proof.ringSig.eCurveB) {
-			return errors.New("invalid proof")
+		ctx.seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// pointKey returns a map key identifying p among points of its curve: its
+// encoding, written in place if possible, or the SHA-512 digest of an
+// encoding longer than the key.
+func pointKey(p Point) [64]byte {
+	var key [64]byte
+	if into, ok := p.(types.PointEncodeInto); ok {
+		_, err := into.EncodeInto(key[:])
+		if err == nil {
+			return key
 		}
 	}
 
+	enc := p.Encode()
+	if len(enc) > len(key) {
+		return sha512.Sum512(enc)
+	}
+
+	copy(key[:], enc)
+	return key
+}
+
+// verifyBitProof calculates the challenges of a bit's ring signature and
+// verifies them.
+func (ctx *VerifyContext) verifyBitProof(curveA, curveB Curve, proof bitProof) error {
+	ctx.metrics.countScalarMuls(8)
+
+	eA0, eB0, err := ctx.recomputeChallenges(curveA, curveB, proof)
+	if err != nil {
+		return err
+	}
+
+	if !eA0.Eq(proof.ringSig.eCurveA) || !eB0.Eq(proof.ringSig.eCurveB) {
+		return errors.New("invalid proof")
+	}
+
 	return nil
 }
+
+// recomputeChallenges walks a bit's ring signature from its stored
+// challenges back around the ring, and returns the challenges it closes
+// with, which equal the stored ones for a valid proof.
+func (ctx *VerifyContext) recomputeChallenges(curveA, curveB Curve, proof bitProof) (Scalar, Scalar, error) {
+	aG := curveA.ScalarMul(proof.ringSig.a1, curveA.AltBasePoint())
+	eCA := proof.commitmentA.commitment.ScalarMul(proof.ringSig.eCurveA)
+
+	bH := curveB.ScalarMul(proof.ringSig.b1, curveB.AltBasePoint())
+	eCB := proof.commitmentB.commitment.ScalarMul(proof.ringSig.eCurveB)
+
+	// both curves' challenges hash the same preimage
+	eA1, eB1, err := ctx.challenges(curveA, curveB, proof, aG.Sub(eCA), bH.Sub(eCB))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commitmentAMinusOne := proof.commitmentA.commitment.Sub(curveA.BasePoint())
+	commitmentBMinusOne := proof.commitmentB.commitment.Sub(curveB.BasePoint())
+
+	aG = curveA.ScalarMul(proof.ringSig.a0, curveA.AltBasePoint())
+	bH = curveB.ScalarMul(proof.ringSig.b0, curveB.AltBasePoint())
+	ecA := commitmentAMinusOne.ScalarMul(eA1)
+	ecB := commitmentBMinusOne.ScalarMul(eB1)
+
+	return ctx.challenges(curveA, curveB, proof, aG.Sub(ecA), bH.Sub(ecB))
+}
+
+// challenges returns the challenges on both curves for the bit's
+// commitments and the points rA and rB, as hashToScalar would.
+func (ctx *VerifyContext) challenges(curveA, curveB Curve, proof bitProof, rA, rB Point) (Scalar, Scalar, error) {
+	start := ctx.metrics.now()
+	defer ctx.metrics.record(phaseChallenges, start)
+
+	ctx.challenge.Reset()
+	ctx.challenge.AddPoint(proof.commitmentA.commitment)
+	ctx.challenge.AddPoint(proof.commitmentB.commitment)
+	ctx.challenge.AddPoint(rA)
+	ctx.challenge.AddPoint(rB)
+
+	eA, err := ctx.challenge.Challenge(curveA)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	eB, err := ctx.challenge.Challenge(curveB)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return eA, eB, nil
+}
+
+// VerifyAgainst verifies the proof is valid against the given curves and
+// that it proves knowledge of the discrete log of the given public keys.
+// The public keys are checked to be valid points of their respective curves
+// before any proof math is done, so points crafted for the other curve are
+// rejected, and to be distinct, see ErrDuplicatePublicKeys.
+func (p *Proof) VerifyAgainst(curveA, curveB Curve, pointA, pointB Point) error {
+	err := curveA.ValidatePoint(pointA)
+	if err != nil {
+		return fmt.Errorf("invalid public key on curve A: %w", err)
+	}
+
+	err = curveB.ValidatePoint(pointB)
+	if err != nil {
+		return fmt.Errorf("invalid public key on curve B: %w", err)
+	}
+
+	if duplicatePublicKeys(curveA, curveB, pointA, pointB) {
+		return ErrDuplicatePublicKeys
+	}
+
+	err = curveA.ValidatePoint(p.CommitmentA)
+	if err != nil {
+		return fmt.Errorf("invalid commitment on curve A: %w", err)
+	}
+
+	err = curveB.ValidatePoint(p.CommitmentB)
+	if err != nil {
+		return fmt.Errorf("invalid commitment on curve B: %w", err)
+	}
+
+	if !p.CommitmentA.Equals(pointA) {
+		return errors.New("proof is not for the given public key on curve A")
+	}
+
+	if !p.CommitmentB.Equals(pointB) {
+		return errors.New("proof is not for the given public key on curve B")
+	}
+
+	return p.Verify(curveA, curveB)
+}
+
+// VerifyAndExtract verifies the proof against the given curves and, only if
+// it's valid, returns the public keys it proves knowledge of the discrete log
+// of. The returned points are copies and are checked to be valid points of
+// their respective curves and to be distinct, see ErrDuplicatePublicKeys.
+func (p *Proof) VerifyAndExtract(curveA, curveB Curve) (pointA, pointB Point, err error) {
+	err = curveA.ValidatePoint(p.CommitmentA)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid commitment on curve A: %w", err)
+	}
+
+	err = curveB.ValidatePoint(p.CommitmentB)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid commitment on curve B: %w", err)
+	}
+
+	if duplicatePublicKeys(curveA, curveB, p.CommitmentA, p.CommitmentB) {
+		return nil, nil, ErrDuplicatePublicKeys
+	}
+
+	err = p.Verify(curveA, curveB)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return p.CommitmentA.Copy(), p.CommitmentB.Copy(), nil
+}
+
+// duplicatePublicKeys reports whether the public keys have the same encoding
+// although the curves, or their generators, differ, see
+// ErrDuplicatePublicKeys.
+func duplicatePublicKeys(curveA, curveB Curve, pointA, pointB Point) bool {
+	return !curveA.Equal(curveB) && bytes.Equal(pointA.Encode(), pointB.Encode())
+}
+
+// VerifySignatureForProvenKey verifies the proof, then verifies sig, made
+// with Curve.Sign, against the key it proves on curveA, CommitmentA. As
+// with Curve.Sign, the message is a point; msg is its compressed encoding
+// on curveA. An invalid proof or message is an error; the result reports
+// whether the signature is valid.
+func VerifySignatureForProvenKey(curveA, curveB Curve, proof *Proof, msg, sig []byte) (bool, error) {
+	err := proof.Verify(curveA, curveB)
+	if err != nil {
+		return false, fmt.Errorf("invalid proof: %w", err)
+	}
+
+	msgPoint, err := curveA.DecodeToPoint(msg)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode message point: %w", err)
+	}
+
+	return curveA.Verify(proof.CommitmentA, msgPoint, sig), nil
+}
+
+// BitChallenges holds the challenges of one bit's ring signature on both
+// curves: as stored in the proof, and as the verifier recomputes them from
+// the rest of the bit proof. They're equal for a valid bit proof.
+type BitChallenges struct {
+	StoredA, StoredB         Scalar
+	RecomputedA, RecomputedB Scalar
+}
+
+// Match reports whether the recomputed challenges equal the stored ones.
+func (c BitChallenges) Match() bool {
+	return c.StoredA.Eq(c.RecomputedA) && c.StoredB.Eq(c.RecomputedB)
+}
+
+// RecomputeChallenges returns the challenges of every bit's ring signature,
+// in bit order, as stored and as recomputed by the verifier, eg. to find
+// where a proof from another implementation diverges from this one. It
+// doesn't check the commitment sums or signatures. Like Verify, it doesn't
+// panic on any proof: a panic in the curve arithmetic is returned as
+// ErrMalformedProof.
+func (p *Proof) RecomputeChallenges(curveA, curveB Curve) ([]BitChallenges, error) {
+	ctx := new(VerifyContext)
+	challenges := make([]BitChallenges, len(p.proofs))
+	err := recoverMalformed(func() error {
+		for i, bp := range p.proofs {
+			eA, eB, err := ctx.recomputeChallenges(curveA, curveB, bp)
+			if err != nil {
+				return fmt.Errorf("failed to recompute challenges of bit %d: %w", i, err)
+			}
+
+			challenges[i] = BitChallenges{
+				StoredA:     bp.ringSig.eCurveA,
+				StoredB:     bp.ringSig.eCurveB,
+				RecomputedA: eA,
+				RecomputedB: eB,
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return challenges, nil
+}
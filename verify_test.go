@@ -0,0 +1,442 @@
+package dleq
+
+import (
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/go-dleq/ed25519"
+	"github.com/pokt-network/go-dleq/secp256k1"
+)
+
+func TestProof_VerifyAgainst(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	err = proof.VerifyAgainst(curveA, curveB, proof.CommitmentA, proof.CommitmentB)
+	require.NoError(t, err)
+
+	// a secp256k1 point passed as the ed25519 public key
+	err = proof.VerifyAgainst(curveA, curveB, proof.CommitmentA, proof.CommitmentA)
+	require.Error(t, err)
+
+	// an ed25519 point passed as the secp256k1 public key
+	err = proof.VerifyAgainst(curveA, curveB, proof.CommitmentB, proof.CommitmentB)
+	require.Error(t, err)
+
+	// valid points that the proof is not for
+	otherA := curveA.ScalarBaseMul(curveA.NewRandomScalar())
+	err = proof.VerifyAgainst(curveA, curveB, otherA, proof.CommitmentB)
+	require.Error(t, err)
+
+	// a public key with a small-order component
+	torsionBytes, err := hex.DecodeString("ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f")
+	require.NoError(t, err)
+	torsion, err := curveB.DecodeToPoint(torsionBytes)
+	require.NoError(t, err)
+	err = proof.VerifyAgainst(curveA, curveB, proof.CommitmentA, proof.CommitmentB.Add(torsion))
+	require.Error(t, err)
+}
+
+func TestProof_DuplicatePublicKeys(t *testing.T) {
+	// with the same curve and generators on both sides, the public keys of
+	// every honest proof are the same point, which is accepted
+	curve := secp256k1.NewCurve()
+	x, err := GenerateSecretForCurves(curve, curve)
+	require.NoError(t, err)
+	proof, err := NewProof(curve, curve, x)
+	require.NoError(t, err)
+	require.True(t, proof.CommitmentA.Equals(proof.CommitmentB))
+
+	enc := proof.CommitmentA.Encode()
+	pointA, err := curve.DecodeToPoint(enc)
+	require.NoError(t, err)
+	pointB, err := curve.DecodeToPoint(enc)
+	require.NoError(t, err)
+	require.NoError(t, proof.VerifyAgainst(curve, curve, pointA, pointB))
+	_, _, err = proof.VerifyAndExtract(curve, curve)
+	require.NoError(t, err)
+
+	// with other generators on curve B, the same bytes as both public keys
+	// are rejected before any proof math
+	gB := curve.BaseMulSmall(2)
+	hB := curve.ScalarBaseMul(curve.NewRandomScalar())
+	curveA, curveB, err := newGeneratorCurves(curve, curve, curve.BasePoint(), curve.AltBasePoint(), gB, hB)
+	require.NoError(t, err)
+	proof, err = NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+	require.NoError(t, proof.VerifyAgainst(curveA, curveB, proof.CommitmentA, proof.CommitmentB))
+
+	err = proof.VerifyAgainst(curveA, curveB, pointA, pointB)
+	require.ErrorIs(t, err, ErrDuplicatePublicKeys)
+
+	forged := *proof
+	forged.CommitmentB = forged.CommitmentA
+	extractedA, extractedB, err := forged.VerifyAndExtract(curveA, curveB)
+	require.ErrorIs(t, err, ErrDuplicatePublicKeys)
+	require.Nil(t, extractedA)
+	require.Nil(t, extractedB)
+}
+
+func TestCurve_ValidatePoint(t *testing.T) {
+	secp := secp256k1.NewCurve()
+	ed := ed25519.NewCurve()
+
+	require.NoError(t, secp.ValidatePoint(secp.BasePoint()))
+	require.NoError(t, ed.ValidatePoint(ed.BasePoint()))
+	require.Error(t, secp.ValidatePoint(ed.BasePoint()))
+	require.Error(t, ed.ValidatePoint(secp.BasePoint()))
+
+	// the identity is not a valid public key
+	require.Error(t, ed.ValidatePoint(ed.BasePoint().Sub(ed.BasePoint())))
+	require.Error(t, secp.ValidatePoint(secp.BasePoint().Sub(secp.BasePoint())))
+}
+
+func TestProof_VerifyAndExtract(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	pointA, pointB, err := proof.VerifyAndExtract(curveA, curveB)
+	require.NoError(t, err)
+	require.True(t, pointA.Equals(curveA.ScalarBaseMul(curveA.ScalarFromBytes(x))))
+	require.True(t, pointB.Equals(curveB.ScalarBaseMul(curveB.ScalarFromBytes(x))))
+
+	// a corrupted proof gives no points
+	ringSig := &proof.proofs[0].ringSig
+	ringSig.a0 = ringSig.a0.Add(curveA.ScalarFromInt(1))
+	pointA, pointB, err = proof.VerifyAndExtract(curveA, curveB)
+	require.Error(t, err)
+	require.Nil(t, pointA)
+	require.Nil(t, pointB)
+}
+
+func TestVerifyStepper(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	stepper := NewVerifyStepper(curveA, curveB, proof)
+	steps := 0
+	for {
+		done, err := stepper.Step()
+		require.NoError(t, err)
+		steps++
+		if done {
+			break
+		}
+	}
+	require.Equal(t, len(proof.proofs), steps)
+	require.NoError(t, proof.Verify(curveA, curveB))
+
+	// stepping past the end gives the same result
+	done, err := stepper.Step()
+	require.True(t, done)
+	require.NoError(t, err)
+
+	// a corrupted bit fails at its own step
+	const corrupted = 100
+	ringSig := &proof.proofs[corrupted].ringSig
+	ringSig.b1 = ringSig.b1.Add(curveB.ScalarFromInt(1))
+
+	stepper = NewVerifyStepper(curveA, curveB, proof)
+	for i := 0; i < corrupted; i++ {
+		done, err := stepper.Step()
+		require.NoError(t, err)
+		require.False(t, done)
+	}
+
+	done, err = stepper.Step()
+	require.True(t, done)
+	require.ErrorContains(t, err, "bit 100")
+	require.Error(t, proof.Verify(curveA, curveB))
+
+	done, err = stepper.Step()
+	require.True(t, done)
+	require.Error(t, err)
+}
+
+func TestProof_Verify_WrongNumberOfBits(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	proof.proofs = proof.proofs[:len(proof.proofs)-1]
+	require.Error(t, proof.Verify(curveA, curveB))
+}
+
+func TestProof_VerifyWith(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+
+	// one context across proofs, valid and not
+	var ctx VerifyContext
+	for i := 0; i < 3; i++ {
+		x, err := GenerateSecretForCurves(curveA, curveB)
+		require.NoError(t, err)
+		proof, err := NewProof(curveA, curveB, x)
+		require.NoError(t, err)
+		require.NoError(t, proof.VerifyWith(&ctx, curveA, curveB))
+
+		ringSig := &proof.proofs[i].ringSig
+		ringSig.a0 = ringSig.a0.Add(curveA.ScalarFromInt(1))
+		require.Error(t, proof.VerifyWith(&ctx, curveA, curveB))
+		require.Error(t, proof.Verify(curveA, curveB))
+	}
+}
+
+func TestVerifySignatureForProvenKey(t *testing.T) {
+	for _, curves := range [][2]Curve{
+		{secp256k1.NewCurve(), ed25519.NewCurve()},
+		{ed25519.NewCurve(), secp256k1.NewCurve()},
+	} {
+		curveA, curveB := curves[0], curves[1]
+		x, err := GenerateSecretForCurves(curveA, curveB)
+		require.NoError(t, err)
+		proof, err := NewProof(curveA, curveB, x)
+		require.NoError(t, err)
+
+		msg := curveA.ScalarBaseMul(curveA.NewRandomScalar())
+		sig, err := curveA.Sign(curveA.ScalarFromBytes(x), msg)
+		require.NoError(t, err)
+		ok, err := VerifySignatureForProvenKey(curveA, curveB, proof, msg.Encode(), sig)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		// a signature by another key, or over another message, doesn't verify
+		other, err := curveA.Sign(curveA.NewRandomScalar(), msg)
+		require.NoError(t, err)
+		ok, err = VerifySignatureForProvenKey(curveA, curveB, proof, msg.Encode(), other)
+		require.NoError(t, err)
+		require.False(t, ok)
+
+		otherMsg := curveA.ScalarBaseMul(curveA.NewRandomScalar())
+		ok, err = VerifySignatureForProvenKey(curveA, curveB, proof, otherMsg.Encode(), sig)
+		require.NoError(t, err)
+		require.False(t, ok)
+
+		// a message that isn't a point is an error
+		_, err = VerifySignatureForProvenKey(curveA, curveB, proof, []byte("msg"), sig)
+		require.Error(t, err)
+
+		// so is a proof that doesn't verify
+		ringSig := &proof.proofs[0].ringSig
+		ringSig.a0 = ringSig.a0.Add(curveA.ScalarFromInt(1))
+		_, err = VerifySignatureForProvenKey(curveA, curveB, proof, msg.Encode(), sig)
+		require.Error(t, err)
+	}
+}
+
+func TestProof_Verify_Malformed(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	// a commitment on the other curve makes the arithmetic panic
+	proof.proofs[3].commitmentA = proof.proofs[3].commitmentB
+	require.NotPanics(t, func() {
+		err = proof.Verify(curveA, curveB)
+	})
+	require.ErrorIs(t, err, ErrMalformedProof)
+
+	// so does a missing signature scalar
+	proof, err = NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+	proof.proofs[5].ringSig.b0 = nil
+	require.NotPanics(t, func() {
+		err = proof.Verify(curveA, curveB)
+	})
+	require.ErrorIs(t, err, ErrMalformedProof)
+
+	// and an empty proof
+	require.NotPanics(t, func() {
+		err = new(Proof).Verify(curveA, curveB)
+	})
+	require.Error(t, err)
+
+	// the stepper stays failed
+	stepper := NewVerifyStepper(curveA, curveB, proof)
+	done, err := stepper.Step()
+	for !done && err == nil {
+		done, err = stepper.Step()
+	}
+	require.True(t, done)
+	require.ErrorIs(t, err, ErrMalformedProof)
+	done, again := stepper.Step()
+	require.True(t, done)
+	require.Equal(t, err, again)
+}
+
+func TestProof_Verify_DegenerateCommitments(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+
+	// a broken random number generator that always returns the same scalar
+	// makes a proof whose bit proofs verify, but whose commitments repeat
+	constant := func(curve Curve) (Scalar, error) {
+		return curve.ScalarFromInt(7), nil
+	}
+	proof, _, err := newProof(curveA, curveB, x, constant, nil)
+	require.NoError(t, err)
+	for _, bp := range proof.proofs {
+		require.NoError(t, new(VerifyContext).verifyBitProof(curveA, curveB, bp))
+	}
+	require.ErrorIs(t, proof.Verify(curveA, curveB), ErrDegenerateCommitments)
+
+	for name, corrupt := range map[string]func(p *Proof){
+		"identity":   func(p *Proof) { p.proofs[4].commitmentA.commitment = curveA.Identity() },
+		"base point": func(p *Proof) { p.proofs[4].commitmentB.commitment = curveB.BasePoint() },
+		"alt base":   func(p *Proof) { p.proofs[4].commitmentA.commitment = curveA.AltBasePoint() },
+		"public key": func(p *Proof) { p.proofs[9].commitmentB.commitment = p.CommitmentB },
+		"repeated":   func(p *Proof) { p.proofs[9].commitmentA = p.proofs[2].commitmentA },
+	} {
+		proof, err := NewProof(curveA, curveB, x)
+		require.NoError(t, err)
+		require.NoError(t, proof.Verify(curveA, curveB))
+
+		corrupt(proof)
+		require.ErrorIs(t, proof.Verify(curveA, curveB), ErrDegenerateCommitments, name)
+	}
+}
+
+func TestVerifyBatchWithBudget(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	proofs := make([]*Proof, 32)
+	for i := range proofs {
+		proofs[i] = proof
+	}
+
+	// no budget verifies nothing, and isn't an error
+	verified, err := VerifyBatchWithBudget(curveA, curveB, proofs, time.Now())
+	require.NoError(t, err)
+	require.Zero(t, verified)
+
+	// a budget running out part way through the fourth proof verifies the
+	// first three, with a budget counted in bits rather than time so the
+	// result doesn't depend on the machine
+	steps := 0
+	verified, err = verifyBatchUntil(curveA, curveB, proofs, func() bool {
+		steps++
+		return steps > 3*len(proof.proofs)+5
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, verified)
+
+	// an invalid proof is an error, after the proofs before it
+	invalid, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+	ringSig := &invalid.proofs[0].ringSig
+	ringSig.a0 = ringSig.a0.Add(curveA.ScalarFromInt(1))
+
+	verified, err = VerifyBatchWithBudget(curveA, curveB, []*Proof{proof, invalid, proof}, time.Now().Add(time.Hour))
+	require.Error(t, err)
+	require.Equal(t, 1, verified)
+
+	verified, err = VerifyBatchWithBudget(curveA, curveB, proofs[:2], time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 2, verified)
+}
+
+func TestProof_RecomputeChallenges(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	challenges, err := proof.RecomputeChallenges(curveA, curveB)
+	require.NoError(t, err)
+	require.Len(t, challenges, len(proof.proofs))
+	for i, c := range challenges {
+		require.True(t, c.Match(), "bit %d", i)
+		require.True(t, c.StoredA.Eq(proof.proofs[i].ringSig.eCurveA))
+		require.True(t, c.RecomputedB.Eq(proof.proofs[i].ringSig.eCurveB))
+	}
+
+	// a corrupted bit proof only diverges at that bit
+	ringSig := &proof.proofs[5].ringSig
+	ringSig.b0 = ringSig.b0.Add(curveB.ScalarFromInt(1))
+	challenges, err = proof.RecomputeChallenges(curveA, curveB)
+	require.NoError(t, err)
+	for i, c := range challenges {
+		require.Equal(t, i != 5, c.Match(), "bit %d", i)
+	}
+
+	// a panic in the curve arithmetic is returned as ErrMalformedProof
+	ringSig.b0 = nil
+	require.NotPanics(t, func() {
+		challenges, err = proof.RecomputeChallenges(curveA, curveB)
+	})
+	require.ErrorIs(t, err, ErrMalformedProof)
+	require.Nil(t, challenges)
+}
+
+func TestProof_VerifyParallel(t *testing.T) {
+	curveA := secp256k1.NewCurve()
+	curveB := ed25519.NewCurve()
+	x, err := GenerateSecretForCurves(curveA, curveB)
+	require.NoError(t, err)
+	proof, err := NewProof(curveA, curveB, x)
+	require.NoError(t, err)
+
+	for _, workers := range []int{0, 1, 4, 1000} {
+		require.NoError(t, proof.VerifyParallel(curveA, curveB, workers))
+	}
+
+	// with corrupted bits, the error is that of the first, as from Verify
+	for _, bits := range [][]int{{200}, {7, 150}, {0, 1, 251}} {
+		corrupted := *proof
+		corrupted.proofs = slices.Clone(proof.proofs)
+		for _, i := range bits {
+			ringSig := &corrupted.proofs[i].ringSig
+			ringSig.a0 = ringSig.a0.Add(curveA.ScalarFromInt(1))
+		}
+
+		expected := corrupted.Verify(curveA, curveB)
+		require.ErrorContains(t, expected, fmt.Sprintf("bit %d:", bits[0]))
+		for _, workers := range []int{1, 4, 1000} {
+			require.EqualError(t, corrupted.VerifyParallel(curveA, curveB, workers), expected.Error())
+		}
+	}
+
+	// a panic in a worker is returned as ErrMalformedProof
+	malformed := *proof
+	malformed.proofs = slices.Clone(proof.proofs)
+	malformed.proofs[100].ringSig.a1 = curveB.ScalarFromInt(1)
+	require.ErrorIs(t, malformed.Verify(curveA, curveB), ErrMalformedProof)
+	require.ErrorIs(t, malformed.VerifyParallel(curveA, curveB, 4), ErrMalformedProof)
+
+	// a proof whose commitments don't verify fails before any bit
+	wrong := *proof
+	wrong.CommitmentA = curveA.ScalarBaseMul(curveA.NewRandomScalar())
+	require.EqualError(t, wrong.VerifyParallel(curveA, curveB, 4), wrong.Verify(curveA, curveB).Error())
+}